@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jpillora/backoff"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -84,6 +86,32 @@ type TransmitChecker[
 	Check(ctx context.Context, l logger.SugaredLogger, tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], a txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
 }
 
+// KeyFundsChecker determines whether a key can pay for a transaction, so that the Broadcaster only assigns
+// pooled transactions to keys with sufficient funds.
+type KeyFundsChecker[
+	CHAIN_ID types.ID,
+	ADDR types.Hashable,
+	TX_HASH, BLOCK_HASH types.Hashable,
+	SEQ types.Sequence,
+	FEE feetypes.Fee,
+] interface {
+	// HasSufficientFunds returns whether the balance of the address covers the value and the fee of the tx
+	HasSufficientFunds(ctx context.Context, address ADDR, tx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (bool, error)
+}
+
+// BatchEncoder encodes the calls of batched transactions into a single call to a Multicall3-style contract, so that
+// the Broadcaster can send them in one transaction.
+type BatchEncoder[
+	CHAIN_ID types.ID,
+	ADDR types.Hashable,
+	TX_HASH, BLOCK_HASH types.Hashable,
+	SEQ types.Sequence,
+	FEE feetypes.Fee,
+] interface {
+	// EncodeBatch returns the payload of the call to the contract making the calls of the txs, and its fee limit
+	EncodeBatch(contract ADDR, txs []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (payload []byte, feeLimit uint64, err error)
+}
+
 // Broadcaster monitors txes for transactions that need to
 // be broadcast, assigns sequences and ensures that at least one node
 // somewhere has received the transaction successfully.
@@ -130,6 +158,12 @@ type Broadcaster[
 
 	checkerFactory TransmitCheckerFactory[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 
+	// keyFundsChecker, if set, prevents pooled txs from being assigned to keys which can't pay for them
+	keyFundsChecker KeyFundsChecker[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+
+	// batchEncoder, if set, sends batchable txs in batches. Otherwise they are sent on their own.
+	batchEncoder BatchEncoder[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+
 	// triggers allow other goroutines to force Broadcaster to rescan the
 	// database early (before the next poll interval)
 	// Each key has its own trigger
@@ -162,6 +196,8 @@ func NewBroadcaster[
 	sequenceTracker txmgrtypes.SequenceTracker[ADDR, SEQ],
 	lggr logger.Logger,
 	checkerFactory TransmitCheckerFactory[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
+	keyFundsChecker KeyFundsChecker[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
+	batchEncoder BatchEncoder[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
 	autoSyncSequence bool,
 ) *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
 	lggr = logger.Named(lggr, "Broadcaster")
@@ -177,6 +213,8 @@ func NewBroadcaster[
 		listenerConfig:   listenerConfig,
 		ks:               keystore,
 		checkerFactory:   checkerFactory,
+		keyFundsChecker:  keyFundsChecker,
+		batchEncoder:     batchEncoder,
 		autoSyncSequence: autoSyncSequence,
 		sequenceTracker:  sequenceTracker,
 	}
@@ -367,6 +405,8 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 	if err != nil {
 		return retryable, fmt.Errorf("processUnstartedTxs failed on handleAnyInProgressTx: %w", err)
 	}
+	// funds checks are cached for the cycle, since pooled txs left for other keys are checked on every iteration
+	funds := make(map[fundsCheck[ADDR]]bool)
 	for {
 		maxInFlightTransactions := eb.txConfig.MaxInFlight()
 		if maxInFlightTransactions > 0 {
@@ -388,6 +428,12 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 				continue
 			}
 		}
+		if err := eb.assignPooledTx(ctx, fromAddress, funds); err != nil {
+			return true, fmt.Errorf("processUnstartedTxs failed on assignPooledTx: %w", err)
+		}
+		if err := eb.batchUnstartedTxs(ctx, fromAddress); err != nil {
+			return true, fmt.Errorf("processUnstartedTxs failed on batchUnstartedTxs: %w", err)
+		}
 		etx, err := eb.nextUnstartedTransactionWithSequence(fromAddress)
 		if err != nil {
 			return true, fmt.Errorf("processUnstartedTxs failed on nextUnstartedTransactionWithSequence: %w", err)
@@ -403,6 +449,177 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 	}
 }
 
+// fundsCheck is the key of the cached result of whether an address can pay for a pooled tx
+type fundsCheck[ADDR types.Hashable] struct {
+	address ADDR
+	txID    int64
+}
+
+// assignPooledTx assigns the next pooled tx whose key pool has the address to it, if it is the least loaded,
+// sufficiently funded enabled key of the pool. Ties go to the address: the keys of the pool may assign the tx
+// concurrently, but only the first one succeeds. Keys of the pool which fail to be checked are skipped, so that
+// they don't stall the queue of the address.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) assignPooledTx(ctx context.Context, fromAddress ADDR, funds map[fundsCheck[ADDR]]bool) error {
+	etx, err := eb.txStore.FindNextUnstartedPooledTx(ctx, fromAddress, eb.chainID)
+	if err != nil {
+		return fmt.Errorf("FindNextUnstartedPooledTx failed: %w", err)
+	}
+	if etx == nil {
+		return nil
+	}
+	lgr := etx.GetLogger(eb.lggr.With("fromAddress", fromAddress))
+
+	load, funded, err := eb.keyLoad(ctx, fromAddress, *etx, funds)
+	if err != nil {
+		return err
+	}
+	if !funded {
+		lgr.Debugw("Insufficient funds to be assigned pooled tx")
+		return nil
+	}
+	for _, address := range etx.KeyPool {
+		if address == fromAddress || !slices.Contains(eb.enabledAddresses, address) {
+			continue
+		}
+		otherLoad, otherFunded, err := eb.keyLoad(ctx, address, *etx, funds)
+		if err != nil {
+			lgr.Warnw("Failed to check key of the pool, skipping it", "address", address, "err", err)
+			continue
+		}
+		if otherFunded && otherLoad < load {
+			// left for the less loaded key to assign to itself
+			return nil
+		}
+	}
+
+	if err = eb.txStore.AssignPooledTx(ctx, etx.ID, fromAddress, eb.chainID); errors.Is(err, sql.ErrNoRows) {
+		lgr.Debugw("Pooled tx already assigned to another key")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("AssignPooledTx failed: %w", err)
+	}
+	lgr.Debugw("Assigned pooled tx", "load", load)
+	return nil
+}
+
+// keyLoad returns the number of unstarted and unconfirmed txs of the address, and whether it can pay for the tx.
+// Whether it can pay is cached in funds.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) keyLoad(ctx context.Context, address ADDR, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], funds map[fundsCheck[ADDR]]bool) (load uint32, funded bool, err error) {
+	nUnstarted, err := eb.txStore.CountUnstartedTransactions(ctx, address, eb.chainID)
+	if err != nil {
+		return 0, false, fmt.Errorf("CountUnstartedTransactions failed: %w", err)
+	}
+	nUnconfirmed, err := eb.txStore.CountUnconfirmedTransactions(ctx, address, eb.chainID)
+	if err != nil {
+		return 0, false, fmt.Errorf("CountUnconfirmedTransactions failed: %w", err)
+	}
+	if eb.keyFundsChecker == nil {
+		return nUnstarted + nUnconfirmed, true, nil
+	}
+	check := fundsCheck[ADDR]{address, etx.ID}
+	funded, ok := funds[check]
+	if !ok {
+		funded, err = eb.keyFundsChecker.HasSufficientFunds(ctx, address, etx)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to check funds of %s: %w", address, err)
+		}
+		funds[check] = funded
+	}
+	return nUnstarted + nUnconfirmed, funded, nil
+}
+
+// batchUnstartedTxs creates a batch tx for the batchable txs of the address, per subject, once they fill a
+// batch or once the oldest of them has waited for the window of its batch policy. Batchable txs are polled
+// for, so they can wait up to the fallback poll interval longer than the window.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) batchUnstartedTxs(ctx context.Context, fromAddress ADDR) error {
+	etxs, err := eb.txStore.FindUnstartedBatchableTxs(ctx, fromAddress, eb.chainID)
+	if err != nil {
+		return fmt.Errorf("FindUnstartedBatchableTxs failed: %w", err)
+	}
+	if len(etxs) == 0 {
+		return nil
+	}
+	if eb.batchEncoder == nil {
+		return eb.unbatchTxs(ctx, etxs)
+	}
+
+	var subjects []uuid.UUID
+	batchable := make(map[uuid.UUID][]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE])
+	for _, etx := range etxs {
+		if _, exists := batchable[etx.Subject.UUID]; !exists {
+			subjects = append(subjects, etx.Subject.UUID)
+		}
+		batchable[etx.Subject.UUID] = append(batchable[etx.Subject.UUID], etx)
+	}
+	for _, subject := range subjects {
+		etxs = batchable[subject]
+		policy, err := etxs[0].GetBatchPolicy()
+		if err != nil {
+			return fmt.Errorf("failed to get batch policy of tx %d: %w", etxs[0].ID, err)
+		}
+		for len(etxs) > 0 {
+			size := min(len(etxs), int(policy.MaxSize))
+			if size < int(policy.MaxSize) && time.Since(etxs[0].CreatedAt) < policy.Window {
+				break
+			}
+			if err = eb.createBatchTx(ctx, fromAddress, *policy, etxs[:size]); err != nil {
+				return err
+			}
+			etxs = etxs[size:]
+		}
+	}
+	return nil
+}
+
+// createBatchTx creates the batch tx making the calls of the txs through the contract of the batch policy
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) createBatchTx(ctx context.Context, fromAddress ADDR, policy txmgrtypes.TxBatchPolicy[ADDR], etxs []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+	if len(etxs) == 1 {
+		// a batch of one tx would only add the overhead of the contract
+		return eb.unbatchTxs(ctx, etxs)
+	}
+	payload, feeLimit, err := eb.batchEncoder.EncodeBatch(policy.Contract, etxs)
+	if err != nil {
+		return fmt.Errorf("failed to encode batch: %w", err)
+	}
+	ids := make([]int64, len(etxs))
+	priority := etxs[0].Priority
+	for i, etx := range etxs {
+		ids[i] = etx.ID
+		priority = max(priority, etx.Priority)
+	}
+	txRequest := txmgrtypes.TxRequest[ADDR, TX_HASH]{
+		FromAddress:    fromAddress,
+		ToAddress:      policy.Contract,
+		EncodedPayload: payload,
+		FeeLimit:       feeLimit,
+		Meta:           &txmgrtypes.TxMeta[ADDR, TX_HASH]{BatchedTxIDs: ids},
+		Strategy:       NewSendEveryStrategy(),
+		Priority:       priority,
+	}
+	batchTx, err := eb.txStore.CreateBatchTx(ctx, txRequest, ids, eb.chainID)
+	if errors.Is(err, sql.ErrNoRows) {
+		// some of the txs were deleted while being batched, the rest are batched again next time
+		eb.lggr.Debugw("Batched txs changed while being batched", "txIDs", ids)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("CreateBatchTx failed: %w", err)
+	}
+	eb.lggr.Infow("Created batch tx", "fromAddress", fromAddress, "batchTxID", batchTx.ID, "txIDs", ids)
+	return nil
+}
+
+// unbatchTxs marks the batchable txs to be sent on their own
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) unbatchTxs(ctx context.Context, etxs []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+	ids := make([]int64, len(etxs))
+	for i, etx := range etxs {
+		ids[i] = etx.ID
+	}
+	if err := eb.txStore.UnbatchTxs(ctx, ids, eb.chainID); err != nil {
+		return fmt.Errorf("UnbatchTxs failed: %w", err)
+	}
+	return nil
+}
+
 // handleInProgressTx checks if there is any transaction
 // in_progress and if so, finishes the job
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) handleAnyInProgressTx(ctx context.Context, fromAddress ADDR) (err error, retryable bool) {
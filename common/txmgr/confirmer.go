@@ -2,6 +2,7 @@ package txmgr
 
 import (
 	"context"
+	"database/sql"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -69,6 +70,10 @@ var (
 		Name: "tx_manager_fwd_tx_count",
 		Help: "The number of forwarded transaction attempts labeled by status",
 	}, []string{"chainID", "successful"})
+	promCancelledTxOutcomes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_cancelled_tx_outcomes",
+		Help: "Number of receipts for transactions cancelled by the transaction manager, labeled by whether the original transaction or its cancellation was mined. Note that this can err to be too high in the case of re-orgs",
+	}, []string{"chainID", "mined"})
 	promTxAttemptCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "tx_manager_tx_attempt_count",
 		Help: "The number of transaction attempts that are currently being processed by the transaction manager",
@@ -131,6 +136,7 @@ type Confirmer[
 
 	ks               txmgrtypes.KeyStore[ADDR, CHAIN_ID, SEQ]
 	enabledAddresses []ADDR
+	stuckTxDetector  *StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
 
 	mb        *mailbox.Mailbox[HEAD]
 	ctx       context.Context
@@ -161,6 +167,7 @@ func NewConfirmer[
 	dbConfig txmgrtypes.ConfirmerDatabaseConfig,
 	keystore txmgrtypes.KeyStore[ADDR, CHAIN_ID, SEQ],
 	txAttemptBuilder txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
+	stuckTxDetector *StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE],
 	lggr logger.Logger,
 	isReceiptNil func(R) bool,
 ) *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
@@ -177,6 +184,7 @@ func NewConfirmer[
 		dbConfig:         dbConfig,
 		chainID:          client.ConfiguredChainID(),
 		ks:               keystore,
+		stuckTxDetector:  stuckTxDetector,
 		mb:               mailbox.NewSingle[HEAD](),
 		isReceiptNil:     isReceiptNil,
 	}
@@ -297,6 +305,15 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pro
 	ec.lggr.Debugw("Finished CheckForReceipts", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
 	mark = time.Now()
 
+	if ec.stuckTxDetector != nil && ec.stuckTxDetector.Enabled() {
+		if err := ec.ProcessStuckTransactions(ctx, head.BlockNumber()); err != nil {
+			return fmt.Errorf("ProcessStuckTransactions failed: %w", err)
+		}
+
+		ec.lggr.Debugw("Finished ProcessStuckTransactions", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
+		mark = time.Now()
+	}
+
 	if err := ec.RebroadcastWhereNecessary(ctx, head.BlockNumber()); err != nil {
 		return fmt.Errorf("RebroadcastWhereNecessary failed: %w", err)
 	}
@@ -310,6 +327,10 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pro
 
 	ec.lggr.Debugw("Finished EnsureConfirmedTransactionsInLongestChain", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
 
+	if err := ec.UpdateBatchedTxs(ctx); err != nil {
+		return fmt.Errorf("UpdateBatchedTxs failed: %w", err)
+	}
+
 	if ec.resumeCallback != nil {
 		mark = time.Now()
 		if err := ec.ResumePendingTaskRuns(ctx, head); err != nil {
@@ -324,6 +345,89 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pro
 	return nil
 }
 
+// UpdateBatchedTxs confirms the batched txs whose batch tx was mined, and unbatches those whose batch tx reverted
+// or failed, for the Broadcaster to send them on their own.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) UpdateBatchedTxs(ctx context.Context) error {
+	confirmed, unbatched, err := ec.txStore.UpdateBatchedTxs(ctx, ec.chainID)
+	if err != nil {
+		return err
+	}
+	if len(confirmed) > 0 {
+		ec.lggr.Debugw("Confirmed batched transactions", "txIDs", confirmed)
+	}
+	if len(unbatched) > 0 {
+		ec.lggr.Warnw("Batch transactions reverted or failed, their transactions will be sent on their own", "txIDs", unbatched)
+	}
+	return nil
+}
+
+// ProcessStuckTransactions purges the terminally stuck txs found by the StuckTxDetector, by replacing each
+// with an empty tx to its from address. Purging frees up the sequence of the stuck tx for the txs queued
+// behind it. The purged txs are marked fatally errored once their purge is mined.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ProcessStuckTransactions(ctx context.Context, blockNum int64) error {
+	stuckTxs, err := ec.stuckTxDetector.DetectStuckTransactions(ctx, ec.enabledAddresses, blockNum)
+	if err != nil {
+		return fmt.Errorf("failed to detect stuck transactions: %w", err)
+	}
+	var errs error
+	for _, tx := range stuckTxs {
+		if err = ec.purgeStuckTx(ctx, tx); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to purge stuck tx %d: %w", tx.ID, err))
+		}
+	}
+
+	ids, err := ec.txStore.MarkPurgedTxsFatal(ctx, ec.chainID)
+	if err != nil {
+		return errors.Join(errs, fmt.Errorf("failed to mark purged transactions fatal: %w", err))
+	}
+	for _, id := range ids {
+		promNumPurgedTxsMarkedFatal.WithLabelValues(ec.chainID.String()).Inc()
+		ec.lggr.Infow("Purge of terminally stuck transaction was mined, marked it as fatally errored", "txID", id)
+	}
+	return errs
+}
+
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) purgeStuckTx(ctx context.Context, tx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+	lggr := tx.GetLogger(ec.lggr)
+	purged := newCancellationTx(tx)
+	meta, err := purgedMeta(tx.Meta)
+	if err != nil {
+		return err
+	}
+	purged.Meta = meta
+
+	previousAttempt := tx.TxAttempts[0]
+	attempt, fee, _, _, err := ec.NewBumpTxAttempt(ctx, purged, previousAttempt, tx.TxAttempts, lggr)
+	if commonfee.IsBumpErr(err) {
+		// stuck txs have usually been bumped to the max fee price, so the purge is sent at that capped fee, which
+		// is the fee of the last attempt. That attempt was likely dropped if the tx is stuck at the max fee price.
+		lggr.Warnw("Failed to bump fee to purge stuck transaction, purging it at the fee of its last attempt", "err", err)
+		fee = previousAttempt.TxFee
+		attempt, _, err = ec.NewCustomTxAttempt(ctx, purged, fee, previousAttempt.ChainSpecificFeeLimit, previousAttempt.TxType, lggr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to build purge attempt: %w", err)
+	}
+	if err = ec.txStore.SaveCancellationAttempt(ctx, &purged, &attempt); err != nil {
+		return fmt.Errorf("failed to save purge attempt: %w", err)
+	}
+	promNumPurgedTxs.WithLabelValues(ec.chainID.String()).Inc()
+	lggr.Warnw("Purging terminally stuck transaction by replacing it with an empty transaction", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "fee", fee.String(), "attempts", len(tx.TxAttempts))
+
+	if tx.PipelineTaskRunID.Valid && ec.resumeCallback != nil && tx.SignalCallback {
+		err = ec.resumeCallback(ctx, tx.PipelineTaskRunID.UUID, nil, fmt.Errorf("transaction %d: %w", tx.ID, ErrTxTerminallyStuck))
+		if errors.Is(err, sql.ErrNoRows) {
+			lggr.Debugw("callback missing or already resumed")
+		} else if err != nil {
+			return fmt.Errorf("failed to resume pipeline: %w", err)
+		} else if err = ec.txStore.UpdateTxCallbackCompleted(ctx, tx.PipelineTaskRunID.UUID, ec.chainID); err != nil {
+			// so that the pipeline isn't resumed again with the receipt of the purge
+			return err
+		}
+	}
+	return nil
+}
+
 // CheckConfirmedMissingReceipt will attempt to re-send any transaction in the
 // state of "confirmed_missing_receipt". If we get back any type of senderror
 // other than "sequence too low" it means that this transaction isn't actually
@@ -586,6 +690,16 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) bat
 			promNumSuccessfulTxs.WithLabelValues(ec.chainID.String()).Add(1)
 		}
 
+		// Txs cancelled by Txm.Cancel confirm with a receipt for either the original tx or its cancellation.
+		if meta, metaErr := attempt.Tx.GetMeta(); metaErr == nil && meta != nil && meta.CancelAttemptID != nil {
+			mined := "original"
+			if attempt.ID >= *meta.CancelAttemptID {
+				mined = "cancellation"
+			}
+			l.Infow("Got receipt for cancelled transaction", "mined", mined)
+			promCancelledTxOutcomes.WithLabelValues(ec.chainID.String(), mined).Add(1)
+		}
+
 		// This is only recording forwarded tx that were mined and have a status.
 		// Counters are prone to being inaccurate due to re-orgs.
 		if ec.txConfig.ForwardersEnabled() {
@@ -3,10 +3,12 @@ package txmgr
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
 )
 
 var _ txmgrtypes.TxStrategy = SendEveryStrategy{}
@@ -62,3 +64,32 @@ func (s DropOldestStrategy) PruneQueue(ctx context.Context, pruneService txmgrty
 	}
 	return
 }
+
+// BatchStrategy will send the txs of its subject in batches, each one a single call to a Multicall3-style
+// contract making the calls of its txs. The calls are made by the contract rather than the from address, so
+// it's only suitable for calls which don't check their sender, and which don't transfer value.
+//
+// A batch is sent once it's full, or once its oldest tx has waited for the window. If the batch reverts, its
+// txs are sent on their own instead, so that each of them gets its own receipt.
+type BatchStrategy[ADDR types.Hashable] struct {
+	subject uuid.UUID
+	policy  txmgrtypes.TxBatchPolicy[ADDR]
+}
+
+// NewBatchStrategy creates a new TxStrategy that batches up to maxSize txs into a single call to the contract,
+// waiting up to window for a batch to fill up.
+func NewBatchStrategy[ADDR types.Hashable](subject uuid.UUID, contract ADDR, window time.Duration, maxSize uint32) BatchStrategy[ADDR] {
+	return BatchStrategy[ADDR]{subject, txmgrtypes.TxBatchPolicy[ADDR]{Contract: contract, Window: window, MaxSize: maxSize}}
+}
+
+func (s BatchStrategy[ADDR]) Subject() uuid.NullUUID {
+	return uuid.NullUUID{UUID: s.subject, Valid: true}
+}
+
+func (s BatchStrategy[ADDR]) PruneQueue(ctx context.Context, pruneService txmgrtypes.UnstartedTxQueuePruner) ([]int64, error) {
+	return nil, nil
+}
+
+func (s BatchStrategy[ADDR]) BatchPolicy() txmgrtypes.TxBatchPolicy[ADDR] {
+	return s.policy
+}
@@ -0,0 +1,133 @@
+package txmgr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// ErrTxTerminallyStuck is the error of purged txs, and of the pipeline runs waiting on them
+var ErrTxTerminallyStuck = errors.New("transaction terminally stuck")
+
+var (
+	promNumPurgedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_purged_transactions",
+		Help: "Number of terminally stuck transactions that have been purged",
+	}, []string{"chainID"})
+	promNumPurgedTxsMarkedFatal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_purged_transactions_marked_fatal",
+		Help: "Number of purged transactions that have been marked fatally errored once their purge was mined",
+	}, []string{"chainID"})
+)
+
+// StuckTxDetector detects terminally stuck txs: txs which are still unconfirmed at least Threshold blocks
+// after they were first broadcast, despite at least MinAttempts attempts. This typically happens when a tx
+// has been bumped to the max fee price and is still not mined, or when it was dropped from the mempool by
+// a private sequencer.
+type StuckTxDetector[
+	CHAIN_ID types.ID,
+	ADDR types.Hashable,
+	TX_HASH types.Hashable,
+	BLOCK_HASH types.Hashable,
+	R txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH],
+	SEQ types.Sequence,
+	FEE feetypes.Fee,
+] struct {
+	lggr    logger.SugaredLogger
+	txStore txmgrtypes.TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
+	cfg     txmgrtypes.AutoPurgeConfig
+	chainID CHAIN_ID
+}
+
+func NewStuckTxDetector[
+	CHAIN_ID types.ID,
+	ADDR types.Hashable,
+	TX_HASH types.Hashable,
+	BLOCK_HASH types.Hashable,
+	R txmgrtypes.ChainReceipt[TX_HASH, BLOCK_HASH],
+	SEQ types.Sequence,
+	FEE feetypes.Fee,
+](
+	lggr logger.Logger,
+	txStore txmgrtypes.TxStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, R, SEQ, FEE],
+	cfg txmgrtypes.AutoPurgeConfig,
+	chainID CHAIN_ID,
+) *StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
+	return &StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{
+		lggr:    logger.Sugared(logger.Named(lggr, "StuckTxDetector")),
+		txStore: txStore,
+		cfg:     cfg,
+		chainID: chainID,
+	}
+}
+
+// Enabled returns whether terminally stuck txs should be detected and purged
+func (d *StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Enabled() bool {
+	return d.cfg.Enabled()
+}
+
+// DetectStuckTransactions returns the oldest unconfirmed tx of each address, if it is terminally stuck at
+// the block number. Later txs of an address can't be mined before its oldest, so they aren't checked.
+func (d *StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) DetectStuckTransactions(ctx context.Context, addresses []ADDR, blockNum int64) ([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
+	var stuckTxs []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	for _, address := range addresses {
+		tx, err := d.txStore.FindOldestUnconfirmedTx(ctx, address, d.chainID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find oldest unconfirmed tx of %s: %w", address, err)
+		}
+		if tx != nil && d.isStuck(tx, blockNum) {
+			stuckTxs = append(stuckTxs, tx)
+		}
+	}
+	return stuckTxs, nil
+}
+
+func (d *StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) isStuck(tx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], blockNum int64) bool {
+	meta, err := tx.GetMeta()
+	if err != nil {
+		tx.GetLogger(d.lggr).Errorw("Failed to parse tx meta, skipping stuck tx detection", "err", err)
+		return false
+	}
+	if meta != nil && meta.CancelAttemptID != nil {
+		// already cancelled or purged, so there is nothing more to do but wait
+		return false
+	}
+	if uint32(len(tx.TxAttempts)) < d.cfg.MinAttempts() {
+		return false
+	}
+	var firstBroadcastBlockNum *int64
+	for _, attempt := range tx.TxAttempts {
+		if attempt.BroadcastBeforeBlockNum != nil && (firstBroadcastBlockNum == nil || *attempt.BroadcastBeforeBlockNum < *firstBroadcastBlockNum) {
+			firstBroadcastBlockNum = attempt.BroadcastBeforeBlockNum
+		}
+	}
+	return firstBroadcastBlockNum != nil && blockNum-*firstBroadcastBlockNum >= int64(d.cfg.Threshold())
+}
+
+// purgedMeta returns the meta with Purged set, keeping any fields unknown to TxMeta
+func purgedMeta(meta *sqlutil.JSON) (*sqlutil.JSON, error) {
+	fields := map[string]any{}
+	if meta != nil {
+		if err := json.Unmarshal(*meta, &fields); err != nil {
+			return nil, fmt.Errorf("failed to parse tx meta: %w", err)
+		}
+	}
+	fields["Purged"] = true
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tx meta: %w", err)
+	}
+	purged := sqlutil.JSON(b)
+	return &purged, nil
+}
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	nullv4 "gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -26,6 +28,19 @@ import (
 // For more information about the Txm architecture, see the design doc:
 // https://www.notion.so/chainlink/Txm-Architecture-Overview-9dc62450cd7a443ba9e7dceffa1a8d6b
 
+var (
+	// ErrTxNotCancellable is returned by Cancel for txs which are neither unstarted nor unconfirmed, which
+	// were already cancelled, or which are batched.
+	ErrTxNotCancellable = errors.New("tx cannot be cancelled")
+	// ErrTxNotFound is returned by Cancel for txs which don't exist.
+	ErrTxNotFound = errors.New("tx not found")
+
+	promNumCancelledTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_cancelled_transactions",
+		Help: "Number of transactions cancelled, labeled by whether the unstarted transaction was deleted or the unconfirmed transaction was replaced",
+	}, []string{"chainID", "action"})
+)
+
 // ResumeCallback is assumed to be idempotent
 type ResumeCallback func(ctx context.Context, id uuid.UUID, result interface{}, err error) error
 
@@ -50,6 +65,8 @@ type TxManager[
 	RegisterResumeCallback(fn ResumeCallback)
 	SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	Reset(addr ADDR, abandon bool) error
+	// Cancel deletes the tx with the given ID if it is unstarted, or replaces it with an empty send if it is unconfirmed
+	Cancel(ctx context.Context, txID int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Find transactions by a field in the TxMeta blob and transaction states
 	FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) (txes []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Find transactions with a non-null TxMeta field that was provided by transaction states
@@ -248,6 +265,124 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) abandon(ad
 	return nil
 }
 
+// Cancel stops Broadcaster/Confirmer, cancels the tx with the given ID, then starts them again.
+//
+// Unstarted txs are deleted. Unconfirmed txs are replaced by a zero-value send to their from address, using
+// the same sequence at a bumped fee, and the Confirmer then bumps and tracks the replacement like any other
+// attempt. Whichever of the original and its replacement is mined confirms the tx, and the CancelAttemptID
+// in its meta tells them apart. Either way, a pipeline run waiting on the tx is resumed with an error.
+//
+// Batch txs, and the txs in them, cannot be cancelled since they share their calls.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Cancel(ctx context.Context, txID int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	ok := b.IfStarted(func() {
+		done := make(chan error)
+		f := func() {
+			etx, err = b.cancel(ctx, txID)
+		}
+
+		b.reset <- reset{f, done}
+		if rerr := <-done; rerr != nil {
+			err = rerr
+		}
+	})
+	if !ok {
+		return etx, errors.New("not started")
+	}
+	return etx, err
+}
+
+// cancel, scoped to the chain of this txm. This must not be run while Broadcaster or Confirmer are running.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) cancel(ctx context.Context, txID int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	tx, err := b.txStore.GetTxByID(ctx, txID)
+	if err != nil {
+		return etx, fmt.Errorf("failed to find tx %d: %w", txID, err)
+	}
+	if tx == nil {
+		return etx, fmt.Errorf("%w: %d", ErrTxNotFound, txID)
+	}
+	if tx.ChainID.String() != b.chainID.String() {
+		return etx, fmt.Errorf("tx %d is on chain %s, not %s", txID, tx.ChainID.String(), b.chainID.String())
+	}
+	lggr := tx.GetLogger(b.logger)
+
+	meta, err := tx.GetMeta()
+	if err != nil {
+		return etx, fmt.Errorf("failed to parse meta of tx %d: %w", txID, err)
+	}
+	if tx.BatchTxID != nil {
+		return etx, fmt.Errorf("%w: tx %d is part of batch tx %d", ErrTxNotCancellable, txID, *tx.BatchTxID)
+	}
+	if meta != nil && len(meta.BatchedTxIDs) > 0 {
+		return etx, fmt.Errorf("%w: tx %d is a batch tx", ErrTxNotCancellable, txID)
+	}
+
+	switch tx.State {
+	case TxUnstarted:
+		if err = b.txStore.DeleteUnstartedTx(ctx, tx.ID, b.chainID); err != nil {
+			return etx, fmt.Errorf("failed to delete unstarted tx %d: %w", txID, err)
+		}
+		promNumCancelledTxs.WithLabelValues(b.chainID.String(), "deleted").Inc()
+		lggr.Infow("Cancelled unstarted tx by deleting it")
+	case TxUnconfirmed:
+		if meta != nil && meta.CancelAttemptID != nil {
+			return etx, fmt.Errorf("%w: tx %d was already cancelled", ErrTxNotCancellable, txID)
+		}
+		if len(tx.TxAttempts) == 0 {
+			return etx, fmt.Errorf("invariant violation: tx %d was unconfirmed but didn't have any attempts", txID)
+		}
+
+		cancelled := newCancellationTx(tx)
+		attempt, bumpedFee, _, _, berr := b.txAttemptBuilder.NewBumpTxAttempt(ctx, cancelled, tx.TxAttempts[0], tx.TxAttempts, lggr)
+		if berr != nil {
+			return etx, fmt.Errorf("failed to bump fee to cancel tx %d: %w", txID, berr)
+		}
+		if err = b.txStore.SaveCancellationAttempt(ctx, &cancelled, &attempt); err != nil {
+			return etx, fmt.Errorf("failed to save cancellation of tx %d: %w", txID, err)
+		}
+		cancelled.TxAttempts = append([]txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{attempt}, tx.TxAttempts...)
+		tx = &cancelled
+		promNumCancelledTxs.WithLabelValues(b.chainID.String(), "replaced").Inc()
+		lggr.Infow("Cancelled unconfirmed tx by replacing it with an empty transaction", "txAttemptID", attempt.ID, "txHash", attempt.Hash, "fee", bumpedFee.String())
+	case TxInProgress:
+		return etx, fmt.Errorf("%w: tx %d is being broadcast, try again once it is unconfirmed", ErrTxNotCancellable, txID)
+	default:
+		return etx, fmt.Errorf("%w: tx %d is %s, only unstarted and unconfirmed txs can be cancelled", ErrTxNotCancellable, txID, tx.State)
+	}
+
+	if tx.PipelineTaskRunID.Valid && b.resumeCallback != nil && tx.SignalCallback {
+		err = b.resumeCallback(ctx, tx.PipelineTaskRunID.UUID, nil, fmt.Errorf("transaction %d was cancelled", tx.ID))
+		if errors.Is(err, sql.ErrNoRows) {
+			lggr.Debugw("callback missing or already resumed")
+		} else if err != nil {
+			return *tx, fmt.Errorf("failed to resume pipeline: %w", err)
+		} else if tx.State == TxUnconfirmed {
+			// Mark tx as having completed callback, so that the Confirmer doesn't resume it with the receipt
+			if err = b.txStore.UpdateTxCallbackCompleted(ctx, tx.PipelineTaskRunID.UUID, b.chainID); err != nil {
+				return *tx, err
+			}
+		}
+	}
+	return *tx, nil
+}
+
+// newCancellationTx returns a copy of the tx which sends nothing to its own from address, to replace it with.
+// The Confirmer re-signs every future bump of the tx from these fields, so they are persisted with the
+// cancellation attempt.
+func newCancellationTx[
+	CHAIN_ID types.ID,
+	ADDR types.Hashable,
+	TX_HASH, BLOCK_HASH types.Hashable,
+	SEQ types.Sequence,
+	FEE feetypes.Fee,
+](tx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	cancelled := *tx
+	cancelled.ToAddress = tx.FromAddress
+	cancelled.Value = big.Int{}
+	cancelled.EncodedPayload = []byte{}
+	cancelled.TxAttempts = nil
+	return cancelled
+}
+
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Close() (merr error) {
 	return b.StopOnce("Txm", func() error {
 		close(b.chStop)
@@ -506,10 +641,21 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 		}
 	}
 
-	if err = b.checkEnabled(ctx, txRequest.FromAddress); err != nil {
+	if len(txRequest.KeyPool) > 0 {
+		// queue the tx under the first enabled key of the pool, until the Broadcaster assigns it
+		if txRequest.FromAddress, err = b.firstEnabledKey(ctx, txRequest.KeyPool); err != nil {
+			return tx, err
+		}
+	} else if err = b.checkEnabled(ctx, txRequest.FromAddress); err != nil {
 		return tx, err
 	}
 
+	if strategy, ok := txRequest.Strategy.(txmgrtypes.BatchingTxStrategy[ADDR]); ok {
+		if err = b.checkBatchable(txRequest, strategy.BatchPolicy()); err != nil {
+			return tx, fmt.Errorf("Txm#CreateTransaction: %w", err)
+		}
+	}
+
 	if b.txConfig.ForwardersEnabled() && (!utils.IsZero(txRequest.ForwarderAddress)) {
 		fwdPayload, fwdErr := b.fwdMgr.ConvertPayload(txRequest.ToAddress, txRequest.EncodedPayload)
 		if fwdErr == nil {
@@ -528,7 +674,11 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 		}
 	}
 
-	err = b.txStore.CheckTxQueueCapacity(ctx, txRequest.FromAddress, b.txConfig.MaxQueued(), b.chainID)
+	if len(txRequest.KeyPool) > 0 {
+		err = b.txStore.CheckPooledTxQueueCapacity(ctx, txRequest.KeyPool, b.txConfig.MaxQueued(), b.chainID)
+	} else {
+		err = b.txStore.CheckTxQueueCapacity(ctx, txRequest.FromAddress, b.txConfig.MaxQueued(), b.chainID)
+	}
 	if err != nil {
 		return tx, fmt.Errorf("Txm#CreateTransaction: %w", err)
 	}
@@ -539,11 +689,51 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CreateTran
 	}
 
 	// Trigger the Broadcaster to check for new transaction
-	b.broadcaster.Trigger(txRequest.FromAddress)
+	if len(txRequest.KeyPool) > 0 {
+		for _, address := range txRequest.KeyPool {
+			b.broadcaster.Trigger(address)
+		}
+	} else {
+		b.broadcaster.Trigger(txRequest.FromAddress)
+	}
 
 	return tx, nil
 }
 
+// checkBatchable returns an error if the tx can't be batched: its call is made by the batching contract, which
+// doesn't transfer value, and the tx has neither attempts nor receipts of its own.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) checkBatchable(txRequest txmgrtypes.TxRequest[ADDR, TX_HASH], policy txmgrtypes.TxBatchPolicy[ADDR]) error {
+	switch {
+	case policy.MaxSize < 2:
+		return fmt.Errorf("batch max size must be at least 2, got %d", policy.MaxSize)
+	case txRequest.Value.Sign() != 0:
+		return errors.New("batched txs cannot transfer value")
+	case txRequest.Checker.CheckerType != "":
+		return errors.New("batched txs cannot have a transmit checker")
+	case txRequest.PipelineTaskRunID != nil || txRequest.SignalCallback:
+		return errors.New("batched txs cannot resume pipeline runs")
+	case len(txRequest.KeyPool) > 0:
+		return errors.New("batched txs cannot be sent from a key pool")
+	case txRequest.FeePolicy != nil:
+		return errors.New("batched txs cannot have a fee policy")
+	case b.txConfig.ForwardersEnabled() && !utils.IsZero(txRequest.ForwarderAddress):
+		return errors.New("batched txs cannot be forwarded")
+	}
+	return nil
+}
+
+// firstEnabledKey returns the first key of the pool which is enabled on the chain
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) firstEnabledKey(ctx context.Context, keyPool []ADDR) (address ADDR, err error) {
+	var errs error
+	for _, address = range keyPool {
+		if err = b.checkEnabled(ctx, address); err == nil {
+			return address, nil
+		}
+		errs = errors.Join(errs, err)
+	}
+	return address, fmt.Errorf("no enabled key in key pool: %w", errs)
+}
+
 // Calls forwarderMgr to get a proper forwarder for a given EOA.
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetForwarderForEOA(eoa ADDR) (forwarder ADDR, err error) {
 	if !b.txConfig.ForwardersEnabled() {
@@ -652,6 +842,9 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Get
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Reset(addr ADDR, abandon bool) error {
 	return nil
 }
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Cancel(ctx context.Context, txID int64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
+	return etx, errors.New(n.ErrMsg)
+}
 
 // SendNativeToken does nothing, null functionality
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
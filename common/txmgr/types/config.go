@@ -65,6 +65,13 @@ type ConfirmerTransactionsConfig interface {
 	ForwardersEnabled() bool
 }
 
+// AutoPurgeConfig is the config subset used by the StuckTxDetector
+type AutoPurgeConfig interface {
+	Enabled() bool
+	Threshold() uint32
+	MinAttempts() uint32
+}
+
 type ResenderChainConfig interface {
 	RPCDefaultBatchSize() uint32
 }
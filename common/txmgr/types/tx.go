@@ -33,6 +33,24 @@ type TxStrategy interface {
 	PruneQueue(ctx context.Context, pruneService UnstartedTxQueuePruner) (ids []int64, err error)
 }
 
+// BatchingTxStrategy is a TxStrategy whose txs are sent in batches, by a Multicall3-style contract
+type BatchingTxStrategy[ADDR types.Hashable] interface {
+	TxStrategy
+	// BatchPolicy will be saved txes.batch_policy
+	BatchPolicy() TxBatchPolicy[ADDR]
+}
+
+// TxBatchPolicy configures how the unstarted txs of a subject are batched into a single tx, which makes their
+// calls through a Multicall3-style contract.
+type TxBatchPolicy[ADDR types.Hashable] struct {
+	// Contract is the Multicall3-style contract making the calls of the batch
+	Contract ADDR
+	// Window is how long the oldest tx of a batch waits for more txs to join it
+	Window time.Duration
+	// MaxSize is the maximum number of txs in a batch. Full batches are sent without waiting for the window.
+	MaxSize uint32
+}
+
 type TxAttemptState int8
 
 type TxState string
@@ -95,6 +113,34 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	// Mark tx requiring callback
 	SignalCallback bool
+
+	// Priority orders the unstarted txs of the from address: txs with a higher priority are broadcast
+	// before those with a lower one, regardless of when they were created. Defaults to TxPriorityDefault.
+	Priority int32
+	// FeePolicy optionally overrides the fee config of the chain for the tx
+	FeePolicy *TxFeePolicy
+
+	// KeyPool, if set, lets the Broadcaster send the tx from the least loaded, sufficiently funded enabled key
+	// of the pool, chosen at broadcast time. FromAddress is then ignored: the tx is queued under the first
+	// enabled key of the pool until it is assigned, but counts against the MaxQueued of the pool, not of that key.
+	KeyPool []ADDR
+}
+
+const (
+	// TxPriorityDefault is the priority of txs which don't set one
+	TxPriorityDefault int32 = 0
+	// TxPriorityHigh is the priority of time-sensitive txs, such as OCR transmissions
+	TxPriorityHigh int32 = 100
+)
+
+// TxFeePolicy overrides the fee config of the chain for a single tx. Unset fields fall back to the chain config.
+type TxFeePolicy struct {
+	// MaxFeePrice caps the fee price of the tx, in the smallest denomination of the native token of the chain.
+	// It can only lower the max fee price of the chain config.
+	MaxFeePrice *big.Int `json:",omitempty"`
+	// BumpPercent is the minimum percentage by which the fee of the tx is bumped. It only takes effect when
+	// higher than the bump percentage of the chain config.
+	BumpPercent *uint16 `json:",omitempty"`
 }
 
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
@@ -161,6 +207,15 @@ type TxMeta[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 	MessageIDs []string `json:"MessageIDs,omitempty"`
 	// SeqNumbers is used by CCIP for tx to committed sequence numbers correlation in logs
 	SeqNumbers []uint64 `json:"SeqNumbers,omitempty"`
+
+	// Used for txs cancelled by Txm.Cancel - the ID of the first attempt replacing the tx with an
+	// empty send to its from address. Attempts with this ID or higher are cancellation attempts.
+	CancelAttemptID *int64 `json:"CancelAttemptID,omitempty"`
+	// Used for terminally stuck txs purged by the StuckTxDetector - the tx is marked fatally errored
+	// once its cancellation is mined
+	Purged *bool `json:"Purged,omitempty"`
+	// Used for batch txs created by the Broadcaster - the IDs of the batched txs whose calls the tx makes
+	BatchedTxIDs []int64 `json:"BatchedTxIDs,omitempty"`
 }
 
 type TxAttempt[
@@ -233,6 +288,16 @@ type Tx[
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+
+	Priority int32
+	// Marshalled TxFeePolicy
+	FeePolicy *sqlutil.JSON
+	// KeyPool is the pool of keys the tx can be sent from, until the Broadcaster assigns it to one of them
+	KeyPool []ADDR
+	// Marshalled TxBatchPolicy, unset if the tx is sent on its own
+	BatchPolicy *sqlutil.JSON
+	// BatchTxID is the ID of the tx making the call of this tx, once it is part of a batch
+	BatchTxID *int64
 }
 
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetError() error {
@@ -325,6 +390,34 @@ func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetLogger(lgr logger
 	return logger.Sugared(lgr)
 }
 
+// GetFeePolicy returns an Tx's fee policy in struct form, unmarshalling it from JSON first. It returns nil if
+// the Tx has no fee policy.
+func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetFeePolicy() (*TxFeePolicy, error) {
+	if e.FeePolicy == nil {
+		return nil, nil
+	}
+	var p TxFeePolicy
+	if err := json.Unmarshal(*e.FeePolicy, &p); err != nil {
+		return nil, fmt.Errorf("unmarshalling fee policy: %w", err)
+	}
+
+	return &p, nil
+}
+
+// GetBatchPolicy returns an Tx's batch policy in struct form, unmarshalling it from JSON first. It returns nil if
+// the Tx is not batched.
+func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetBatchPolicy() (*TxBatchPolicy[ADDR], error) {
+	if e.BatchPolicy == nil {
+		return nil, nil
+	}
+	var p TxBatchPolicy[ADDR]
+	if err := json.Unmarshal(*e.BatchPolicy, &p); err != nil {
+		return nil, fmt.Errorf("unmarshalling batch policy: %w", err)
+	}
+
+	return &p, nil
+}
+
 // GetChecker returns an Tx's transmit checker spec in struct form, unmarshalling it from JSON
 // first.
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetChecker() (TransmitCheckerSpec[ADDR], error) {
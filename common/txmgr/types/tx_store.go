@@ -37,12 +37,18 @@ type TxStore[
 
 	// Find confirmed txes beyond the minConfirmations param that require callback but have not yet been signaled
 	FindTxesPendingCallback(ctx context.Context, blockNum int64, chainID CHAIN_ID) (receiptsPlus []ReceiptPlus[R], err error)
+	// AssignPooledTx assigns the unstarted pooled tx to the address, and returns sql.ErrNoRows if it was already
+	// assigned or is no longer unstarted
+	AssignPooledTx(ctx context.Context, id int64, fromAddress ADDR, chainID CHAIN_ID) error
 	// Update tx to mark that its callback has been signaled
 	UpdateTxCallbackCompleted(ctx context.Context, pipelineTaskRunRid uuid.UUID, chainId CHAIN_ID) error
 	SaveFetchedReceipts(ctx context.Context, receipts []R, chainID CHAIN_ID) (err error)
 
 	// additional methods for tx store management
 	CheckTxQueueCapacity(ctx context.Context, fromAddress ADDR, maxQueuedTransactions uint64, chainID CHAIN_ID) (err error)
+	// CheckPooledTxQueueCapacity returns an error if the unassigned pooled txs which can be sent from any key of the
+	// pool reach the limit. They don't count against the queue of any single key until they are assigned.
+	CheckPooledTxQueueCapacity(ctx context.Context, keyPool []ADDR, maxQueuedTransactions uint64, chainID CHAIN_ID) (err error)
 	Close()
 	Abandon(ctx context.Context, id CHAIN_ID, addr ADDR) error
 	// Find transactions by a field in the TxMeta blob and transaction states
@@ -68,7 +74,12 @@ type TransactionStore[
 	CountTransactionsByState(ctx context.Context, state TxState, chainID CHAIN_ID) (count uint32, err error)
 	CountUnstartedTransactions(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (count uint32, err error)
 	CreateTransaction(ctx context.Context, txRequest TxRequest[ADDR, TX_HASH], chainID CHAIN_ID) (tx Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// CreateBatchTx creates the tx making the calls of the unstarted batchable txs with the given IDs, and returns
+	// sql.ErrNoRows if any of them is no longer waiting to be batched
+	CreateBatchTx(ctx context.Context, txRequest TxRequest[ADDR, TX_HASH], ids []int64, chainID CHAIN_ID) (tx Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	DeleteInProgressAttempt(ctx context.Context, attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
+	// DeleteUnstartedTx deletes the tx with the given ID, if it is still unstarted
+	DeleteUnstartedTx(ctx context.Context, id int64, chainID CHAIN_ID) error
 	FindLatestSequence(ctx context.Context, fromAddress ADDR, chainId CHAIN_ID) (SEQ, error)
 	FindTxsRequiringGasBump(ctx context.Context, address ADDR, blockNum, gasBumpThreshold, depth int64, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	FindTxsRequiringResubmissionDueToInsufficientFunds(ctx context.Context, address ADDR, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
@@ -79,7 +90,13 @@ type TransactionStore[
 	FindTxWithIdempotencyKey(ctx context.Context, idempotencyKey string, chainID CHAIN_ID) (tx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Search for Tx using the fromAddress and sequence
 	FindTxWithSequence(ctx context.Context, fromAddress ADDR, seq SEQ) (etx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// FindOldestUnconfirmedTx returns the unconfirmed tx with the lowest sequence of the address, or nil if it has none
+	FindOldestUnconfirmedTx(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (etx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error)
+	// FindNextUnstartedPooledTx returns the next unassigned tx whose key pool has the address, or nil if there is none
+	FindNextUnstartedPooledTx(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (etx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// FindUnstartedBatchableTxs returns the unstarted txs of the address waiting to be batched, oldest first
+	FindUnstartedBatchableTxs(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	FindTransactionsConfirmedInBlockRange(ctx context.Context, highBlockNumber, lowBlockNumber int64, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	FindEarliestUnconfirmedBroadcastTime(ctx context.Context, chainID CHAIN_ID) (null.Time, error)
 	FindEarliestUnconfirmedTxAttemptBlock(ctx context.Context, chainID CHAIN_ID) (null.Int, error)
@@ -92,14 +109,27 @@ type TransactionStore[
 	MarkAllConfirmedMissingReceipt(ctx context.Context, chainID CHAIN_ID) (err error)
 	MarkOldTxesMissingReceiptAsErrored(ctx context.Context, blockNum int64, finalityDepth uint32, chainID CHAIN_ID) error
 	PreloadTxes(ctx context.Context, attempts []TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
+	// MarkPurgedTxsFatal marks the purged txs whose cancellation was mined as fatally errored, and returns their IDs
+	MarkPurgedTxsFatal(ctx context.Context, chainID CHAIN_ID) (ids []int64, err error)
 	SaveConfirmedMissingReceiptAttempt(ctx context.Context, timeout time.Duration, attempt *TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], broadcastAt time.Time) error
 	SaveInProgressAttempt(ctx context.Context, attempt *TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
+	// SaveCancellationAttempt replaces the payload of the unconfirmed tx with its cancellation, and saves the
+	// in_progress attempt sending it
+	SaveCancellationAttempt(ctx context.Context, etx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt *TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
 	SaveInsufficientFundsAttempt(ctx context.Context, timeout time.Duration, attempt *TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], broadcastAt time.Time) error
 	SaveReplacementInProgressAttempt(ctx context.Context, oldAttempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], replacementAttempt *TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
 	SaveSentAttempt(ctx context.Context, timeout time.Duration, attempt *TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], broadcastAt time.Time) error
 	SetBroadcastBeforeBlockNum(ctx context.Context, blockNum int64, chainID CHAIN_ID) error
+	// UnbatchTxs marks the unstarted batchable txs with the given IDs to be sent on their own
+	UnbatchTxs(ctx context.Context, ids []int64, chainID CHAIN_ID) error
+	// UpdateBatchedTxs confirms the batched txs whose batch tx was mined, and unbatches those whose batch tx
+	// reverted or failed. It returns the IDs of the confirmed and of the unbatched txs.
+	UpdateBatchedTxs(ctx context.Context, chainID CHAIN_ID) (confirmed []int64, unbatched []int64, err error)
 	UpdateBroadcastAts(ctx context.Context, now time.Time, etxIDs []int64) error
 	UpdateTxAttemptInProgressToBroadcast(ctx context.Context, etx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], NewAttemptState TxAttemptState) error
+	// AssignPooledTx assigns the unstarted pooled tx to the address, and returns sql.ErrNoRows if it was already
+	// assigned or is no longer unstarted
+	AssignPooledTx(ctx context.Context, id int64, fromAddress ADDR, chainID CHAIN_ID) error
 	// Update tx to mark that its callback has been signaled
 	UpdateTxCallbackCompleted(ctx context.Context, pipelineTaskRunRid uuid.UUID, chainId CHAIN_ID) error
 	UpdateTxsUnconfirmed(ctx context.Context, ids []int64) error
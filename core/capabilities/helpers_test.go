@@ -0,0 +1,19 @@
+package capabilities
+
+import (
+	"time"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
+
+	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+)
+
+// NewTestRegistrySyncer calls NewRegistrySyncer and overrides the interval between attempts to expose local capabilities.
+func NewTestRegistrySyncer(peerWrapper p2ptypes.PeerWrapper, registry core.CapabilitiesRegistry, dispatcher remotetypes.Dispatcher, callbackCapabilities []commoncap.CapabilityInfo, lggr logger.Logger, retryInterval time.Duration) *registrySyncer {
+	s := NewRegistrySyncer(peerWrapper, registry, dispatcher, callbackCapabilities, lggr)
+	s.retryInterval = retryInterval
+	return s
+}
@@ -0,0 +1,54 @@
+// Package metadata identifies the individual requests made to a capability within a workflow execution.
+//
+// commoncap.RequestMetadata only carries the workflow and workflow execution IDs, but an execution can
+// make several requests to the same capability, e.g. one per step using it or one per element of a step's
+// forEach list, and retries a failed request as a new one. The engine passes the ID of each request under
+// a reserved key of the request config, so that it reaches remote capabilities along with the rest of the
+// request.
+package metadata
+
+import (
+	"fmt"
+	"maps"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+)
+
+// RequestIDConfigKey is the reserved key of the request config holding the request ID
+const RequestIDConfigKey = "__requestID"
+
+// NewRequestID returns the ID of the request executing a step of a workflow execution. Steps with a forEach
+// list make one request per element, identified by its index; a negative index stands for a step without one.
+// Retries of a failed request are identified by their attempt number, starting from 0 for the first attempt.
+func NewRequestID(workflowExecutionID string, stepRef string, forEachIndex int, attempt int) string {
+	id := fmt.Sprintf("%s/%s", workflowExecutionID, stepRef)
+	if forEachIndex >= 0 {
+		id = fmt.Sprintf("%s/%d", id, forEachIndex)
+	}
+	if attempt > 0 {
+		id = fmt.Sprintf("%s/attempt-%d", id, attempt)
+	}
+	return id
+}
+
+// WithRequestID returns a copy of the request config with the request ID set
+func WithRequestID(config *values.Map, requestID string) *values.Map {
+	withID := &values.Map{Underlying: map[string]values.Value{}}
+	if config != nil && config.Underlying != nil {
+		withID.Underlying = maps.Clone(config.Underlying)
+	}
+	withID.Underlying[RequestIDConfigKey] = values.NewString(requestID)
+	return withID
+}
+
+// RequestID returns the ID of the request. Requests without one, which aren't made by a workflow
+// engine, are identified by their workflow execution ID.
+func RequestID(req commoncap.CapabilityRequest) string {
+	if req.Config != nil {
+		if id, ok := req.Config.Underlying[RequestIDConfigKey].(*values.String); ok && id.Underlying != "" {
+			return id.Underlying
+		}
+	}
+	return req.Metadata.WorkflowExecutionID
+}
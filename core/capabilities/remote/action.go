@@ -0,0 +1,22 @@
+package remote
+
+import (
+	"time"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var _ commoncap.ActionCapability = &callbackCaller{}
+
+// NewRemoteActionCaller returns an action capability that executes requests on a remote capability DON.
+// Responses are combined by the given aggregator, which defaults to requiring F+1 identical responses.
+func NewRemoteActionCaller(capInfo commoncap.CapabilityInfo, capDonInfo commoncap.DON, localDonInfo commoncap.DON, dispatcher types.Dispatcher, aggregator types.Aggregator, requestTimeout time.Duration, lggr logger.Logger) *callbackCaller {
+	return newCallbackCaller("RemoteActionCaller", capInfo, capDonInfo, localDonInfo, dispatcher, aggregator, requestTimeout, lggr)
+}
+
+// NewRemoteActionReceiver exposes a local action capability to workflow DONs.
+func NewRemoteActionReceiver(underlying commoncap.ActionCapability, capInfo commoncap.CapabilityInfo, localDonInfo commoncap.DON, workflowDONs map[string]commoncap.DON, dispatcher types.Dispatcher, requestTimeout time.Duration, lggr logger.Logger) *callbackReceiver {
+	return newCallbackReceiver("RemoteActionReceiver", underlying, capInfo, localDonInfo, workflowDONs, dispatcher, requestTimeout, lggr)
+}
@@ -0,0 +1,115 @@
+package remote_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestAction_CustomAggregator(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	capInfo := commoncap.CapabilityInfo{
+		ID:             "cap_id",
+		CapabilityType: commoncap.CapabilityTypeAction,
+		Description:    "Remote Action",
+		Version:        "0.0.1",
+	}
+	capDonInfo := commoncap.DON{ID: "capability-don", Members: newPeerIDs(t, 4), F: 1}
+	workflowDonInfo := commoncap.DON{ID: "workflow-don", Members: newPeerIDs(t, 1), F: 0}
+	workflowDONs := map[string]commoncap.DON{workflowDonInfo.ID: workflowDonInfo}
+
+	result, err := values.Wrap("computed")
+	require.NoError(t, err)
+	underlying := &testTarget{response: commoncap.CapabilityResponse{Value: result}}
+
+	broker := newTestBroker()
+	for _, peerID := range capDonInfo.Members {
+		receiver := remote.NewRemoteActionReceiver(underlying, capInfo, capDonInfo, workflowDONs, broker.NewDispatcher(peerID), time.Minute, lggr)
+		require.NoError(t, receiver.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, receiver.Close()) })
+		broker.SetReceiver(peerID, receiver)
+	}
+	aggregator := &countingAggregator{}
+	caller := remote.NewRemoteActionCaller(capInfo, capDonInfo, workflowDonInfo, broker.NewDispatcher(workflowDonInfo.Members[0]), aggregator, time.Minute, lggr)
+	require.NoError(t, caller.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, caller.Close()) })
+	broker.SetReceiver(workflowDonInfo.Members[0], caller)
+
+	require.NoError(t, caller.RegisterToWorkflow(ctx, commoncap.RegisterToWorkflowRequest{}))
+	responseCh, err := caller.Execute(ctx, commoncap.CapabilityRequest{
+		Metadata: commoncap.RequestMetadata{
+			WorkflowID:          workflowID1,
+			WorkflowExecutionID: workflowExecutionID1,
+		},
+	})
+	require.NoError(t, err)
+
+	response := <-responseCh
+	require.NoError(t, response.Err)
+	// aggregation is attempted as soon as F+1 responses are available
+	count, err := response.Value.Unwrap()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+}
+
+func TestAction_CancelledRequest(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	capInfo := commoncap.CapabilityInfo{
+		ID:             "cap_id",
+		CapabilityType: commoncap.CapabilityTypeAction,
+		Description:    "Remote Action",
+		Version:        "0.0.1",
+	}
+	capDonInfo := commoncap.DON{ID: "capability-don", Members: newPeerIDs(t, 4), F: 1}
+	workflowDonInfo := commoncap.DON{ID: "workflow-don", Members: newPeerIDs(t, 1), F: 0}
+
+	// the capability DON never responds
+	broker := newTestBroker()
+	caller := remote.NewRemoteActionCaller(capInfo, capDonInfo, workflowDonInfo, broker.NewDispatcher(workflowDonInfo.Members[0]), nil, time.Minute, lggr)
+	require.NoError(t, caller.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, caller.Close()) })
+
+	request := commoncap.CapabilityRequest{
+		Metadata: commoncap.RequestMetadata{
+			WorkflowID:          workflowID1,
+			WorkflowExecutionID: workflowExecutionID1,
+		},
+	}
+	requestCtx, cancel := context.WithCancel(ctx)
+	responseCh, err := caller.Execute(requestCtx, request)
+	require.NoError(t, err)
+	cancel()
+
+	select {
+	case response := <-responseCh:
+		require.ErrorIs(t, response.Err, context.Canceled)
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for the response")
+	}
+	_, open := <-responseCh
+	require.False(t, open)
+
+	// the cancelled request was dropped, so it can be sent again
+	_, err = caller.Execute(ctx, request)
+	require.NoError(t, err)
+}
+
+type countingAggregator struct{}
+
+func (a *countingAggregator) Aggregate(_ string, responses [][]byte) (commoncap.CapabilityResponse, error) {
+	count, err := values.Wrap(len(responses))
+	if err != nil {
+		return commoncap.CapabilityResponse{}, err
+	}
+	return commoncap.CapabilityResponse{Value: count}, nil
+}
@@ -0,0 +1,278 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sync "sync"
+	"time"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/pb"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/metadata"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+)
+
+// maximum interval between two checks for expired requests
+const maxExpiryCheckInterval = time.Second
+
+// callbackCaller is a shim for remote action, consensus and target capabilities.
+// It translates between capability API calls and network messages.
+// Its responsibilities are:
+//  1. Send Execute requests to all members of the capability DON.
+//  2. Collect responses from remote nodes and aggregate them via a customizable aggregator.
+//  3. Fail requests that can't be aggregated before the request timeout.
+//
+// callbackCaller communicates with corresponding callbackReceivers on remote nodes.
+type callbackCaller struct {
+	name           string
+	capInfo        commoncap.CapabilityInfo
+	capDonInfo     commoncap.DON
+	capDonMembers  map[p2ptypes.PeerID]struct{}
+	localDonInfo   commoncap.DON
+	dispatcher     types.Dispatcher
+	aggregator     types.Aggregator
+	minResponses   uint32
+	requestTimeout time.Duration
+	requests       map[string]*callerRequest
+	mu             sync.Mutex // protects requests
+	stopCh         services.StopChan
+	wg             sync.WaitGroup
+	lggr           logger.Logger
+}
+
+type callerRequest struct {
+	responseCh  chan commoncap.CapabilityResponse
+	done        chan struct{}
+	createdAt   time.Time
+	responders  map[p2ptypes.PeerID]struct{}
+	payloads    [][]byte
+	errorCounts map[types.Error]uint32
+}
+
+var _ commoncap.CallbackCapability = &callbackCaller{}
+var _ types.Receiver = &callbackCaller{}
+var _ services.Service = &callbackCaller{}
+
+func newCallbackCaller(name string, capInfo commoncap.CapabilityInfo, capDonInfo commoncap.DON, localDonInfo commoncap.DON, dispatcher types.Dispatcher, aggregator types.Aggregator, requestTimeout time.Duration, lggr logger.Logger) *callbackCaller {
+	if aggregator == nil {
+		// NOTE: require F+1 identical responses by default, introduce different strategies later (KS-76)
+		aggregator = NewDefaultModeAggregator(uint32(capDonInfo.F + 1))
+	}
+	capDonMembers := make(map[p2ptypes.PeerID]struct{})
+	for _, member := range capDonInfo.Members {
+		capDonMembers[member] = struct{}{}
+	}
+	return &callbackCaller{
+		name:           name,
+		capInfo:        capInfo,
+		capDonInfo:     capDonInfo,
+		capDonMembers:  capDonMembers,
+		localDonInfo:   localDonInfo,
+		dispatcher:     dispatcher,
+		aggregator:     aggregator,
+		minResponses:   uint32(capDonInfo.F + 1),
+		requestTimeout: requestTimeout,
+		requests:       make(map[string]*callerRequest),
+		stopCh:         make(services.StopChan),
+		lggr:           lggr,
+	}
+}
+
+func (c *callbackCaller) Start(ctx context.Context) error {
+	c.wg.Add(1)
+	go c.expiryLoop()
+	c.lggr.Infow("started", "name", c.name, "capabilityId", c.capInfo.ID)
+	return nil
+}
+
+func (c *callbackCaller) Info(ctx context.Context) (commoncap.CapabilityInfo, error) {
+	return c.capInfo, nil
+}
+
+// NOTE: workflow registrations are not propagated to the capability DON yet - remote capabilities
+// receive the full config with every Execute request.
+func (c *callbackCaller) RegisterToWorkflow(ctx context.Context, request commoncap.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (c *callbackCaller) UnregisterFromWorkflow(ctx context.Context, request commoncap.UnregisterFromWorkflowRequest) error {
+	return nil
+}
+
+func (c *callbackCaller) Execute(ctx context.Context, request commoncap.CapabilityRequest) (<-chan commoncap.CapabilityResponse, error) {
+	if request.Metadata.WorkflowExecutionID == "" {
+		return nil, errors.New("empty workflowExecutionID")
+	}
+	// NOTE: request ID is used as the message ID - it has to be identical on all workflow DON nodes
+	// and distinguishes requests made by different steps of the same workflow execution
+	messageID := metadata.RequestID(request)
+	rawRequest, err := pb.MarshalCapabilityRequest(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal capability request: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.requests[messageID]; exists {
+		return nil, fmt.Errorf("request %s already exists", messageID)
+	}
+	req := &callerRequest{
+		responseCh:  make(chan commoncap.CapabilityResponse, 1),
+		done:        make(chan struct{}),
+		createdAt:   time.Now(),
+		responders:  make(map[p2ptypes.PeerID]struct{}),
+		errorCounts: make(map[types.Error]uint32),
+	}
+	c.requests[messageID] = req
+
+	// NOTE: send to all nodes by default, introduce different strategies later (KS-76)
+	for _, peerID := range c.capDonInfo.Members {
+		m := &types.MessageBody{
+			CapabilityId:    c.capInfo.ID,
+			CapabilityDonId: c.capDonInfo.ID,
+			CallerDonId:     c.localDonInfo.ID,
+			Method:          types.MethodExecute,
+			MessageId:       []byte(messageID),
+			Payload:         rawRequest,
+		}
+		err = c.dispatcher.Send(peerID, m)
+		if err != nil {
+			c.lggr.Errorw("failed to send execute request", "capabilityId", c.capInfo.ID, "peerID", peerID, "err", err)
+		}
+	}
+	c.lggr.Debugw("sent execute requests", "capabilityId", c.capInfo.ID, "requestID", messageID, "nMembers", len(c.capDonInfo.Members))
+
+	c.wg.Add(1)
+	go c.cancelOnDone(ctx, messageID, req)
+	return req.responseCh, nil
+}
+
+// cancelOnDone completes the request with the error of ctx if ctx is done before the request is completed.
+func (c *callbackCaller) cancelOnDone(ctx context.Context, messageID string, req *callerRequest) {
+	defer c.wg.Done()
+	select {
+	case <-ctx.Done():
+	case <-req.done:
+		return
+	case <-c.stopCh:
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.requests[messageID] != req {
+		return
+	}
+	c.lggr.Debugw("request cancelled", "capabilityId", c.capInfo.ID, "requestID", messageID, "nResponses", len(req.responders))
+	c.completeRequest(messageID, req, commoncap.CapabilityResponse{Err: context.Cause(ctx)})
+}
+
+func (c *callbackCaller) Receive(msg *types.MessageBody) {
+	sender := ToPeerID(msg.Sender)
+	if _, found := c.capDonMembers[sender]; !found {
+		c.lggr.Errorw("received message from unexpected node", "capabilityId", c.capInfo.ID, "sender", sender)
+		return
+	}
+	if msg.Method != types.MethodExecute {
+		c.lggr.Errorw("received response with unknown method", "method", msg.Method, "sender", sender)
+		return
+	}
+	messageID := string(msg.MessageId)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	req, found := c.requests[messageID]
+	if !found {
+		c.lggr.Debugw("received response for unknown, completed or expired request", "capabilityId", c.capInfo.ID, "requestID", messageID, "sender", sender)
+		return
+	}
+	if _, responded := req.responders[sender]; responded {
+		c.lggr.Warnw("received duplicate response", "capabilityId", c.capInfo.ID, "requestID", messageID, "sender", sender)
+		return
+	}
+	req.responders[sender] = struct{}{}
+
+	if msg.Error != types.Error_OK {
+		req.errorCounts[msg.Error]++
+		if req.errorCounts[msg.Error] >= c.minResponses {
+			c.completeRequest(messageID, req, commoncap.CapabilityResponse{Err: fmt.Errorf("remote capability returned error: %s", msg.Error)})
+			return
+		}
+	} else {
+		req.payloads = append(req.payloads, msg.Payload)
+		if uint32(len(req.payloads)) >= c.minResponses {
+			response, err := c.aggregator.Aggregate(messageID, req.payloads)
+			if err == nil {
+				c.lggr.Debugw("remote responses aggregated", "capabilityId", c.capInfo.ID, "requestID", messageID)
+				c.completeRequest(messageID, req, response)
+				return
+			}
+			c.lggr.Debugw("failed to aggregate responses", "capabilityId", c.capInfo.ID, "requestID", messageID, "nResponses", len(req.payloads), "err", err)
+			if len(req.responders) == len(c.capDonInfo.Members) {
+				c.completeRequest(messageID, req, commoncap.CapabilityResponse{Err: err})
+				return
+			}
+		}
+	}
+	if len(req.responders) == len(c.capDonInfo.Members) {
+		c.completeRequest(messageID, req, commoncap.CapabilityResponse{Err: errors.New("not enough valid responses")})
+	}
+}
+
+// completeRequest sends the final response, closes the response channel and forgets the request,
+// so that late responses are ignored. Must be called with c.mu held.
+func (c *callbackCaller) completeRequest(messageID string, req *callerRequest, response commoncap.CapabilityResponse) {
+	delete(c.requests, messageID)
+	req.responseCh <- response
+	close(req.responseCh)
+	close(req.done)
+}
+
+func (c *callbackCaller) expiryLoop() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(min(c.requestTimeout, maxExpiryCheckInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		case <-ticker.C:
+			c.mu.Lock()
+			for messageID, req := range c.requests {
+				if time.Since(req.createdAt) < c.requestTimeout {
+					continue
+				}
+				c.lggr.Warnw("request timed out", "capabilityId", c.capInfo.ID, "requestID", messageID, "nResponses", len(req.responders))
+				c.completeRequest(messageID, req, commoncap.CapabilityResponse{Err: errors.New("request timed out")})
+			}
+			c.mu.Unlock()
+		}
+	}
+}
+
+func (c *callbackCaller) Close() error {
+	close(c.stopCh)
+	c.wg.Wait()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for messageID, req := range c.requests {
+		c.completeRequest(messageID, req, commoncap.CapabilityResponse{Err: errors.New("remote capability caller closed")})
+	}
+	c.lggr.Infow("closed", "name", c.name, "capabilityId", c.capInfo.ID)
+	return nil
+}
+
+func (c *callbackCaller) Ready() error {
+	return nil
+}
+
+func (c *callbackCaller) HealthReport() map[string]error {
+	return nil
+}
+
+func (c *callbackCaller) Name() string {
+	return c.name
+}
@@ -0,0 +1,237 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	sync "sync"
+	"time"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/pb"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+)
+
+// callbackReceiver manages all external callers of a local action, consensus or target capability.
+// Its responsibilities are:
+//  1. Collect Execute requests from workflow DON nodes until F+1 identical requests are received.
+//  2. Execute the underlying, concrete target implementation once per request.
+//  3. Send the response to every node that requested it, including nodes that ask after execution completed.
+//
+// callbackReceiver communicates with corresponding callbackCallers on remote nodes.
+type callbackReceiver struct {
+	name           string
+	underlying     commoncap.CallbackCapability
+	capInfo        commoncap.CapabilityInfo
+	localDonInfo   commoncap.DON
+	workflowDONs   map[string]commoncap.DON
+	dispatcher     types.Dispatcher
+	requestTimeout time.Duration
+	requests       map[receiverRequestKey]*receiverRequest
+	mu             sync.Mutex // protects requests
+	stopCh         services.StopChan
+	wg             sync.WaitGroup
+	lggr           logger.Logger
+}
+
+type receiverRequestKey struct {
+	callerDonId string
+	messageId   string
+}
+
+type receiverRequest struct {
+	createdAt  time.Time
+	requesters map[p2ptypes.PeerID][]byte
+	started    bool
+	response   []byte
+}
+
+var _ types.Receiver = &callbackReceiver{}
+var _ services.Service = &callbackReceiver{}
+
+func newCallbackReceiver(name string, underlying commoncap.CallbackCapability, capInfo commoncap.CapabilityInfo, localDonInfo commoncap.DON, workflowDONs map[string]commoncap.DON, dispatcher types.Dispatcher, requestTimeout time.Duration, lggr logger.Logger) *callbackReceiver {
+	return &callbackReceiver{
+		name:           name,
+		underlying:     underlying,
+		capInfo:        capInfo,
+		localDonInfo:   localDonInfo,
+		workflowDONs:   workflowDONs,
+		dispatcher:     dispatcher,
+		requestTimeout: requestTimeout,
+		requests:       make(map[receiverRequestKey]*receiverRequest),
+		stopCh:         make(services.StopChan),
+		lggr:           lggr,
+	}
+}
+
+func (r *callbackReceiver) Start(ctx context.Context) error {
+	r.wg.Add(1)
+	go r.expiryLoop()
+	r.lggr.Infow("started", "name", r.name, "capabilityId", r.capInfo.ID)
+	return nil
+}
+
+func (r *callbackReceiver) Receive(msg *types.MessageBody) {
+	sender := ToPeerID(msg.Sender)
+	if msg.Method != types.MethodExecute {
+		r.lggr.Errorw("received request with unknown method", "method", msg.Method, "sender", sender)
+		return
+	}
+	callerDon, ok := r.workflowDONs[msg.CallerDonId]
+	if !ok {
+		r.lggr.Errorw("received a message from unsupported workflow DON", "capabilityId", r.capInfo.ID, "callerDonId", msg.CallerDonId)
+		return
+	}
+	if !isMember(callerDon, sender) {
+		r.lggr.Errorw("received a message from a node outside of the caller DON", "capabilityId", r.capInfo.ID, "callerDonId", msg.CallerDonId, "sender", sender)
+		return
+	}
+	key := receiverRequestKey{callerDonId: msg.CallerDonId, messageId: string(msg.MessageId)}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	req, exists := r.requests[key]
+	if !exists {
+		req = &receiverRequest{
+			createdAt:  time.Now(),
+			requesters: make(map[p2ptypes.PeerID][]byte),
+		}
+		r.requests[key] = req
+	}
+	req.requesters[sender] = msg.Payload
+	if req.response != nil {
+		// execution already completed - respond right away
+		r.sendResponse(key, sender, req.response)
+		return
+	}
+	if req.started {
+		return
+	}
+	payloads := make([][]byte, 0, len(req.requesters))
+	for _, payload := range req.requesters {
+		payloads = append(payloads, payload)
+	}
+	aggregated, err := AggregateModeRaw(payloads, uint32(callerDon.F+1))
+	if err != nil {
+		r.lggr.Debugw("not ready to execute yet", "capabilityId", r.capInfo.ID, "requestID", key.messageId, "nRequests", len(payloads))
+		return
+	}
+	req.started = true
+	r.wg.Add(1)
+	go r.executeRequest(key, aggregated)
+}
+
+func (r *callbackReceiver) executeRequest(key receiverRequestKey, rawRequest []byte) {
+	defer r.wg.Done()
+	response := r.execute(rawRequest)
+	marshaled, err := pb.MarshalCapabilityResponse(response)
+	if err != nil {
+		r.lggr.Errorw("failed to marshal capability response", "capabilityId", r.capInfo.ID, "requestID", key.messageId, "err", err)
+		// callers are still waiting - respond with the error instead
+		marshaled, err = pb.MarshalCapabilityResponse(commoncap.CapabilityResponse{Err: fmt.Errorf("failed to marshal capability response: %w", err)})
+		if err != nil {
+			r.lggr.Errorw("failed to marshal error response", "capabilityId", r.capInfo.ID, "requestID", key.messageId, "err", err)
+			return
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	req, found := r.requests[key]
+	if !found {
+		r.lggr.Warnw("request expired before execution completed", "capabilityId", r.capInfo.ID, "requestID", key.messageId)
+		return
+	}
+	req.response = marshaled
+	for peerID := range req.requesters {
+		r.sendResponse(key, peerID, marshaled)
+	}
+}
+
+func (r *callbackReceiver) execute(rawRequest []byte) commoncap.CapabilityResponse {
+	request, err := pb.UnmarshalCapabilityRequest(rawRequest)
+	if err != nil {
+		return commoncap.CapabilityResponse{Err: fmt.Errorf("failed to unmarshal capability request: %w", err)}
+	}
+	ctx, cancel := r.stopCh.CtxCancel(context.WithTimeout(context.Background(), r.requestTimeout))
+	defer cancel()
+	responseCh, err := r.underlying.Execute(ctx, request)
+	if err != nil {
+		return commoncap.CapabilityResponse{Err: err}
+	}
+	select {
+	case <-ctx.Done():
+		return commoncap.CapabilityResponse{Err: fmt.Errorf("underlying capability did not respond: %w", ctx.Err())}
+	case response, ok := <-responseCh:
+		if !ok {
+			return commoncap.CapabilityResponse{}
+		}
+		return response
+	}
+}
+
+// sendResponse sends a response to a single caller. Must be called with r.mu held.
+func (r *callbackReceiver) sendResponse(key receiverRequestKey, peerID p2ptypes.PeerID, payload []byte) {
+	msg := &types.MessageBody{
+		CapabilityId:    r.capInfo.ID,
+		CapabilityDonId: r.localDonInfo.ID,
+		CallerDonId:     key.callerDonId,
+		Method:          types.MethodExecute,
+		MessageId:       []byte(key.messageId),
+		Payload:         payload,
+	}
+	err := r.dispatcher.Send(peerID, msg)
+	if err != nil {
+		r.lggr.Errorw("failed to send execute response", "capabilityId", r.capInfo.ID, "requestID", key.messageId, "peerID", peerID, "err", err)
+	}
+}
+
+func (r *callbackReceiver) expiryLoop() {
+	defer r.wg.Done()
+	ticker := time.NewTicker(min(r.requestTimeout, maxExpiryCheckInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		case <-ticker.C:
+			r.mu.Lock()
+			for key, req := range r.requests {
+				if time.Since(req.createdAt) >= r.requestTimeout {
+					delete(r.requests, key)
+				}
+			}
+			r.mu.Unlock()
+		}
+	}
+}
+
+func (r *callbackReceiver) Close() error {
+	close(r.stopCh)
+	r.wg.Wait()
+	r.lggr.Infow("closed", "name", r.name, "capabilityId", r.capInfo.ID)
+	return nil
+}
+
+func (r *callbackReceiver) Ready() error {
+	return nil
+}
+
+func (r *callbackReceiver) HealthReport() map[string]error {
+	return nil
+}
+
+func (r *callbackReceiver) Name() string {
+	return r.name
+}
+
+func isMember(don commoncap.DON, peerID p2ptypes.PeerID) bool {
+	for _, member := range don.Members {
+		if member == peerID {
+			return true
+		}
+	}
+	return false
+}
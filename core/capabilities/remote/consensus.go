@@ -0,0 +1,22 @@
+package remote
+
+import (
+	"time"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var _ commoncap.ConsensusCapability = &callbackCaller{}
+
+// NewRemoteConsensusCaller returns a consensus capability that executes requests on a remote capability DON.
+// Responses are combined by the given aggregator, which defaults to requiring F+1 identical responses.
+func NewRemoteConsensusCaller(capInfo commoncap.CapabilityInfo, capDonInfo commoncap.DON, localDonInfo commoncap.DON, dispatcher types.Dispatcher, aggregator types.Aggregator, requestTimeout time.Duration, lggr logger.Logger) *callbackCaller {
+	return newCallbackCaller("RemoteConsensusCaller", capInfo, capDonInfo, localDonInfo, dispatcher, aggregator, requestTimeout, lggr)
+}
+
+// NewRemoteConsensusReceiver exposes a local consensus capability to workflow DONs.
+func NewRemoteConsensusReceiver(underlying commoncap.ConsensusCapability, capInfo commoncap.CapabilityInfo, localDonInfo commoncap.DON, workflowDONs map[string]commoncap.DON, dispatcher types.Dispatcher, requestTimeout time.Duration, lggr logger.Logger) *callbackReceiver {
+	return newCallbackReceiver("RemoteConsensusReceiver", underlying, capInfo, localDonInfo, workflowDONs, dispatcher, requestTimeout, lggr)
+}
@@ -0,0 +1,106 @@
+package remote_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestConsensus_CallerAndReceiver(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	capInfo := commoncap.CapabilityInfo{
+		ID:             "cap_id",
+		CapabilityType: commoncap.CapabilityTypeConsensus,
+		Description:    "Remote Consensus",
+		Version:        "0.0.1",
+	}
+	capDonInfo := commoncap.DON{ID: "capability-don", Members: newPeerIDs(t, 4), F: 1}
+	workflowDonInfo := commoncap.DON{ID: "workflow-don", Members: newPeerIDs(t, 4), F: 1}
+	workflowDONs := map[string]commoncap.DON{workflowDonInfo.ID: workflowDonInfo}
+
+	result, err := values.Wrap(map[string]any{"reports": []any{"report1", "report2"}})
+	require.NoError(t, err)
+	underlying := &testTarget{response: commoncap.CapabilityResponse{Value: result}}
+
+	broker := newTestBroker()
+	for _, peerID := range capDonInfo.Members {
+		receiver := remote.NewRemoteConsensusReceiver(underlying, capInfo, capDonInfo, workflowDONs, broker.NewDispatcher(peerID), time.Minute, lggr)
+		require.NoError(t, receiver.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, receiver.Close()) })
+		broker.SetReceiver(peerID, receiver)
+	}
+	var responseChs []<-chan commoncap.CapabilityResponse
+	for _, peerID := range workflowDonInfo.Members {
+		caller := remote.NewRemoteConsensusCaller(capInfo, capDonInfo, workflowDonInfo, broker.NewDispatcher(peerID), nil, time.Minute, lggr)
+		require.NoError(t, caller.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, caller.Close()) })
+		broker.SetReceiver(peerID, caller)
+
+		responseCh, err := caller.Execute(ctx, commoncap.CapabilityRequest{
+			Metadata: commoncap.RequestMetadata{
+				WorkflowID:          workflowID1,
+				WorkflowExecutionID: workflowExecutionID1,
+			},
+		})
+		require.NoError(t, err)
+		responseChs = append(responseChs, responseCh)
+	}
+
+	for _, responseCh := range responseChs {
+		response := <-responseCh
+		require.NoError(t, response.Err)
+		require.Equal(t, result, response.Value)
+	}
+	// each node on the capability DON ran consensus exactly once, for all workflow DON nodes
+	require.Eventually(t, func() bool {
+		return underlying.ExecutionCount() == len(capDonInfo.Members)
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+}
+
+func TestConsensus_UnderlyingError(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	capInfo := commoncap.CapabilityInfo{
+		ID:             "cap_id",
+		CapabilityType: commoncap.CapabilityTypeConsensus,
+		Description:    "Remote Consensus",
+		Version:        "0.0.1",
+	}
+	capDonInfo := commoncap.DON{ID: "capability-don", Members: newPeerIDs(t, 2), F: 1}
+	workflowDonInfo := commoncap.DON{ID: "workflow-don", Members: newPeerIDs(t, 1), F: 0}
+	workflowDONs := map[string]commoncap.DON{workflowDonInfo.ID: workflowDonInfo}
+
+	underlying := &testTarget{response: commoncap.CapabilityResponse{Err: errors.New("consensus failed")}}
+
+	broker := newTestBroker()
+	for _, peerID := range capDonInfo.Members {
+		receiver := remote.NewRemoteConsensusReceiver(underlying, capInfo, capDonInfo, workflowDONs, broker.NewDispatcher(peerID), time.Minute, lggr)
+		require.NoError(t, receiver.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, receiver.Close()) })
+		broker.SetReceiver(peerID, receiver)
+	}
+	caller := remote.NewRemoteConsensusCaller(capInfo, capDonInfo, workflowDonInfo, broker.NewDispatcher(workflowDonInfo.Members[0]), nil, time.Minute, lggr)
+	require.NoError(t, caller.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, caller.Close()) })
+	broker.SetReceiver(workflowDonInfo.Members[0], caller)
+
+	responseCh, err := caller.Execute(ctx, commoncap.CapabilityRequest{
+		Metadata: commoncap.RequestMetadata{
+			WorkflowID:          workflowID1,
+			WorkflowExecutionID: workflowExecutionID1,
+		},
+	})
+	require.NoError(t, err)
+
+	response := <-responseCh
+	require.ErrorContains(t, response.Err, "consensus failed")
+}
@@ -1,30 +1,275 @@
 package remote_test
 
 import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
-	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
-	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/pb"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/metadata"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
+	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
 	remoteMocks "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
 )
 
-func TestTarget_Placeholder(t *testing.T) {
+const workflowExecutionID1 = "workflowExecutionID1"
+
+func TestTarget_CallerAndReceiver(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	ctx := testutils.Context(t)
-	donInfo := &capabilities.DON{
-		Members: []p2ptypes.PeerID{{}},
+	capInfo := commoncap.CapabilityInfo{
+		ID:             "cap_id",
+		CapabilityType: commoncap.CapabilityTypeTarget,
+		Description:    "Remote Target",
+		Version:        "0.0.1",
 	}
+	capDonInfo := commoncap.DON{ID: "capability-don", Members: newPeerIDs(t, 4), F: 1}
+	workflowDonInfo := commoncap.DON{ID: "workflow-don", Members: newPeerIDs(t, 4), F: 1}
+	workflowDONs := map[string]commoncap.DON{workflowDonInfo.ID: workflowDonInfo}
+
+	result, err := values.Wrap("tx_hash")
+	require.NoError(t, err)
+	underlying := &testTarget{response: commoncap.CapabilityResponse{Value: result}}
+
+	broker := newTestBroker()
+	for _, peerID := range capDonInfo.Members {
+		receiver := remote.NewRemoteTargetReceiver(underlying, capInfo, capDonInfo, workflowDONs, broker.NewDispatcher(peerID), time.Minute, lggr)
+		require.NoError(t, receiver.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, receiver.Close()) })
+		broker.SetReceiver(peerID, receiver)
+	}
+	var responseChs []<-chan commoncap.CapabilityResponse
+	for _, peerID := range workflowDonInfo.Members {
+		caller := remote.NewRemoteTargetCaller(capInfo, capDonInfo, workflowDonInfo, broker.NewDispatcher(peerID), time.Minute, lggr)
+		require.NoError(t, caller.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, caller.Close()) })
+		broker.SetReceiver(peerID, caller)
+
+		responseCh, err := caller.Execute(ctx, commoncap.CapabilityRequest{
+			Metadata: commoncap.RequestMetadata{
+				WorkflowID:          workflowID1,
+				WorkflowExecutionID: workflowExecutionID1,
+			},
+		})
+		require.NoError(t, err)
+		responseChs = append(responseChs, responseCh)
+	}
+
+	for _, responseCh := range responseChs {
+		response := <-responseCh
+		require.NoError(t, response.Err)
+		require.Equal(t, result, response.Value)
+		_, ok := <-responseCh
+		require.False(t, ok)
+	}
+	// each node on the capability DON executed the underlying target exactly once
+	require.Eventually(t, func() bool {
+		return underlying.ExecutionCount() == len(capDonInfo.Members)
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+}
+
+func TestTarget_CallerRejectsDuplicateRequests(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	capDonInfo := commoncap.DON{ID: "capability-don", Members: newPeerIDs(t, 1)}
+	dispatcher := remoteMocks.NewDispatcher(t)
+	dispatcher.On("Send", mock.Anything, mock.Anything).Return(nil)
+	caller := remote.NewRemoteTargetCaller(commoncap.CapabilityInfo{}, capDonInfo, commoncap.DON{}, dispatcher, time.Minute, lggr)
+
+	_, err := caller.Execute(ctx, commoncap.CapabilityRequest{})
+	require.Error(t, err)
+
+	request := commoncap.CapabilityRequest{Metadata: commoncap.RequestMetadata{WorkflowExecutionID: workflowExecutionID1}}
+	_, err = caller.Execute(ctx, request)
+	require.NoError(t, err)
+	_, err = caller.Execute(ctx, request)
+	require.Error(t, err)
+}
+
+func TestTarget_CallerSeparatesRequestsOfAnExecution(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	capDonInfo := commoncap.DON{ID: "capability-don", Members: newPeerIDs(t, 1)}
+	dispatcher := remoteMocks.NewDispatcher(t)
+	dispatcher.On("Send", mock.Anything, mock.Anything).Return(nil)
+	caller := remote.NewRemoteTargetCaller(commoncap.CapabilityInfo{}, capDonInfo, commoncap.DON{}, dispatcher, time.Minute, lggr)
+
+	newRequest := func(stepRef string) commoncap.CapabilityRequest {
+		return commoncap.CapabilityRequest{
+			Metadata: commoncap.RequestMetadata{WorkflowExecutionID: workflowExecutionID1},
+			Config:   metadata.WithRequestID(nil, metadata.NewRequestID(workflowExecutionID1, stepRef, -1, 0)),
+		}
+	}
+	respond := func(stepRef string) {
+		payload, err := pb.MarshalCapabilityResponse(commoncap.CapabilityResponse{Value: values.NewString(stepRef)})
+		require.NoError(t, err)
+		caller.Receive(&remotetypes.MessageBody{
+			Sender:    capDonInfo.Members[0][:],
+			Method:    remotetypes.MethodExecute,
+			MessageId: []byte(metadata.NewRequestID(workflowExecutionID1, stepRef, -1, 0)),
+			Payload:   payload,
+		})
+	}
+
+	// requests of two steps of the same execution are in flight at the same time
+	responseCh1, err := caller.Execute(ctx, newRequest("step1"))
+	require.NoError(t, err)
+	responseCh2, err := caller.Execute(ctx, newRequest("step2"))
+	require.NoError(t, err)
+	respond("step2")
+	respond("step1")
+	require.Equal(t, values.NewString("step1"), (<-responseCh1).Value)
+	require.Equal(t, values.NewString("step2"), (<-responseCh2).Value)
+
+	// a completed request is forgotten right away, so it can be sent again
+	responseCh1, err = caller.Execute(ctx, newRequest("step1"))
+	require.NoError(t, err)
+	respond("step1")
+	require.Equal(t, values.NewString("step1"), (<-responseCh1).Value)
+}
+
+func TestTarget_CallerTimeout(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	capDonInfo := commoncap.DON{ID: "capability-don", Members: newPeerIDs(t, 1)}
+	dispatcher := remoteMocks.NewDispatcher(t)
+	dispatcher.On("Send", mock.Anything, mock.Anything).Return(nil)
+	caller := remote.NewRemoteTargetCaller(commoncap.CapabilityInfo{}, capDonInfo, commoncap.DON{}, dispatcher, 50*time.Millisecond, lggr)
+	require.NoError(t, caller.Start(ctx))
+
+	responseCh, err := caller.Execute(ctx, commoncap.CapabilityRequest{
+		Metadata: commoncap.RequestMetadata{WorkflowExecutionID: workflowExecutionID1},
+	})
+	require.NoError(t, err)
+	response := <-responseCh
+	require.ErrorContains(t, response.Err, "timed out")
+	require.NoError(t, caller.Close())
+}
+
+func TestTarget_CallerNotEnoughIdenticalResponses(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	capDonInfo := commoncap.DON{ID: "capability-don", Members: newPeerIDs(t, 3), F: 1}
 	dispatcher := remoteMocks.NewDispatcher(t)
 	dispatcher.On("Send", mock.Anything, mock.Anything).Return(nil)
-	target := remote.NewRemoteTargetCaller(commoncap.CapabilityInfo{}, donInfo, dispatcher, lggr)
+	caller := remote.NewRemoteTargetCaller(commoncap.CapabilityInfo{}, capDonInfo, commoncap.DON{}, dispatcher, time.Minute, lggr)
+
+	responseCh, err := caller.Execute(ctx, commoncap.CapabilityRequest{
+		Metadata: commoncap.RequestMetadata{WorkflowExecutionID: workflowExecutionID1},
+	})
+	require.NoError(t, err)
+	for i, peerID := range capDonInfo.Members {
+		value, err := values.Wrap(i)
+		require.NoError(t, err)
+		payload, err := pb.MarshalCapabilityResponse(commoncap.CapabilityResponse{Value: value})
+		require.NoError(t, err)
+		caller.Receive(&remotetypes.MessageBody{
+			Sender:    peerID[:],
+			Method:    remotetypes.MethodExecute,
+			MessageId: []byte(workflowExecutionID1),
+			Payload:   payload,
+		})
+	}
+	response := <-responseCh
+	require.ErrorContains(t, response.Err, "not enough identical responses")
+}
+
+func newPeerIDs(t *testing.T, n int) []p2ptypes.PeerID {
+	peerIDs := make([]p2ptypes.PeerID, n)
+	for i := range peerIDs {
+		_, err := rand.Read(peerIDs[i][:])
+		require.NoError(t, err)
+	}
+	return peerIDs
+}
 
-	_, err := target.Execute(ctx, commoncap.CapabilityRequest{})
-	assert.NoError(t, err)
+type testTarget struct {
+	response   commoncap.CapabilityResponse
+	executions int
+	mu         sync.Mutex
+}
+
+func (t *testTarget) Info(ctx context.Context) (commoncap.CapabilityInfo, error) {
+	return commoncap.CapabilityInfo{}, nil
+}
+
+func (t *testTarget) RegisterToWorkflow(ctx context.Context, request commoncap.RegisterToWorkflowRequest) error {
+	return errors.New("not implemented")
+}
+
+func (t *testTarget) UnregisterFromWorkflow(ctx context.Context, request commoncap.UnregisterFromWorkflowRequest) error {
+	return errors.New("not implemented")
+}
+
+func (t *testTarget) Execute(ctx context.Context, request commoncap.CapabilityRequest) (<-chan commoncap.CapabilityResponse, error) {
+	t.mu.Lock()
+	t.executions++
+	t.mu.Unlock()
+	ch := make(chan commoncap.CapabilityResponse, 1)
+	ch <- t.response
+	close(ch)
+	return ch, nil
+}
+
+func (t *testTarget) ExecutionCount() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.executions
+}
+
+// testBroker delivers messages between in-process dispatchers asynchronously, like a real network would.
+type testBroker struct {
+	receivers map[p2ptypes.PeerID]remotetypes.Receiver
+	mu        sync.RWMutex
+}
+
+func newTestBroker() *testBroker {
+	return &testBroker{receivers: make(map[p2ptypes.PeerID]remotetypes.Receiver)}
+}
+
+func (b *testBroker) SetReceiver(peerID p2ptypes.PeerID, receiver remotetypes.Receiver) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.receivers[peerID] = receiver
+}
+
+func (b *testBroker) NewDispatcher(peerID p2ptypes.PeerID) remotetypes.Dispatcher {
+	return &testDispatcher{peerID: peerID, broker: b}
+}
+
+type testDispatcher struct {
+	peerID p2ptypes.PeerID
+	broker *testBroker
+}
+
+func (d *testDispatcher) SetReceiver(capabilityId string, donId string, receiver remotetypes.Receiver) error {
+	return nil
+}
+
+func (d *testDispatcher) RemoveReceiver(capabilityId string, donId string) {}
+
+func (d *testDispatcher) Send(peerID p2ptypes.PeerID, msgBody *remotetypes.MessageBody) error {
+	msg := proto.Clone(msgBody).(*remotetypes.MessageBody)
+	msg.Sender = d.peerID[:]
+	msg.Receiver = peerID[:]
+	d.broker.mu.RLock()
+	receiver, ok := d.broker.receivers[peerID]
+	d.broker.mu.RUnlock()
+	if !ok {
+		return errors.New("unknown peer")
+	}
+	go receiver.Receive(msg)
+	return nil
 }
@@ -4,6 +4,7 @@ const (
 	DefaultRegistrationRefreshMs = 30_000
 	DefaultRegistrationExpiryMs  = 120_000
 	DefaultMessageExpiryMs       = 120_000
+	DefaultRequestTimeoutMs      = 120_000
 )
 
 // NOTE: consider splitting this config into values stored in Registry (KS-118)
@@ -2,6 +2,8 @@ package capabilities
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"slices"
 	"sync"
 	"time"
@@ -23,15 +25,23 @@ import (
 )
 
 type registrySyncer struct {
-	peerWrapper p2ptypes.PeerWrapper
-	registry    core.CapabilitiesRegistry
-	dispatcher  remotetypes.Dispatcher
-	subServices []services.Service
-	lggr        logger.Logger
+	peerWrapper          p2ptypes.PeerWrapper
+	registry             core.CapabilitiesRegistry
+	dispatcher           remotetypes.Dispatcher
+	callbackCapabilities []commoncap.CapabilityInfo
+	retryInterval        time.Duration
+	subServices          []services.Service
+	mu                   sync.Mutex // protects subServices
+	stopCh               services.StopChan
+	wg                   sync.WaitGroup
+	lggr                 logger.Logger
 }
 
 var _ services.Service = &registrySyncer{}
 
+// interval between attempts to expose a local capability that isn't in the registry yet
+const defaultLocalCapabilityRetryInterval = 5 * time.Second
+
 var defaultStreamConfig = p2ptypes.StreamConfig{
 	IncomingMessageBufferSize: 1000000,
 	OutgoingMessageBufferSize: 1000000,
@@ -46,13 +56,21 @@ var defaultStreamConfig = p2ptypes.StreamConfig{
 	},
 }
 
-// RegistrySyncer updates local Registry to match its onchain counterpart
-func NewRegistrySyncer(peerWrapper p2ptypes.PeerWrapper, registry core.CapabilitiesRegistry, dispatcher remotetypes.Dispatcher, lggr logger.Logger) *registrySyncer {
+// RegistrySyncer updates local Registry to match its onchain counterpart.
+//
+// callbackCapabilities are the action, consensus and target capabilities hosted by the capability DON. Workflow
+// DON members call them remotely and capability DON members expose their local instance of each, once it is
+// added to the registry.
+// NOTE: callbackCapabilities are temporary until capabilities are read from the onchain registry
+func NewRegistrySyncer(peerWrapper p2ptypes.PeerWrapper, registry core.CapabilitiesRegistry, dispatcher remotetypes.Dispatcher, callbackCapabilities []commoncap.CapabilityInfo, lggr logger.Logger) *registrySyncer {
 	return &registrySyncer{
-		peerWrapper: peerWrapper,
-		registry:    registry,
-		dispatcher:  dispatcher,
-		lggr:        lggr,
+		peerWrapper:          peerWrapper,
+		registry:             registry,
+		dispatcher:           dispatcher,
+		callbackCapabilities: callbackCapabilities,
+		retryInterval:        defaultLocalCapabilityRetryInterval,
+		stopCh:               make(services.StopChan),
+		lggr:                 lggr,
 	}
 }
 
@@ -160,7 +178,28 @@ func (s *registrySyncer) Start(ctx context.Context) error {
 		}
 		s.subServices = append(s.subServices, triggerCap)
 	}
+	// NOTE: callback capabilities are hosted by the same DON as the trigger
+	callbackCapabilities := make([]commoncap.CapabilityInfo, 0, len(s.callbackCapabilities))
+	for _, capInfo := range s.callbackCapabilities {
+		switch capInfo.CapabilityType {
+		case commoncap.CapabilityTypeAction, commoncap.CapabilityTypeConsensus, commoncap.CapabilityTypeTarget:
+		default:
+			return fmt.Errorf("unsupported remote capability type %s for capability %s", capInfo.CapabilityType, capInfo.ID)
+		}
+		capInfo.DON = &triggerCapabilityDonInfo
+		callbackCapabilities = append(callbackCapabilities, capInfo)
+	}
+	if slices.Contains(workflowDONPeers, myId) {
+		for _, capInfo := range callbackCapabilities {
+			err = s.addRemoteCallbackCaller(ctx, capInfo, triggerCapabilityDonInfo, workflowDonInfo)
+			if err != nil {
+				return err
+			}
+		}
+	}
 	// NOTE: temporary service start - should be managed by capability creation
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	for _, srv := range s.subServices {
 		err = srv.Start(ctx)
 		if err != nil {
@@ -168,11 +207,127 @@ func (s *registrySyncer) Start(ctx context.Context) error {
 			return err
 		}
 	}
+	if slices.Contains(triggerDONPeers, myId) {
+		workflowDONs := map[string]capabilities.DON{
+			workflowDonInfo.ID: workflowDonInfo,
+		}
+		for _, capInfo := range callbackCapabilities {
+			// NOTE: the local capability is usually added to the registry by a job, which may not be running yet
+			s.wg.Add(1)
+			go s.exposeLocalCapabilityLoop(capInfo, triggerCapabilityDonInfo, workflowDONs)
+		}
+	}
 	s.lggr.Info("registry syncer started")
 	return nil
 }
 
+type remoteCallbackCaller interface {
+	commoncap.CallbackCapability
+	remotetypes.Receiver
+	services.Service
+}
+
+type remoteCallbackReceiver interface {
+	remotetypes.Receiver
+	services.Service
+}
+
+// addRemoteCallbackCaller sets up a caller for an action, consensus or target capability hosted by the capability DON.
+func (s *registrySyncer) addRemoteCallbackCaller(ctx context.Context, capInfo commoncap.CapabilityInfo, capDonInfo capabilities.DON, workflowDonInfo capabilities.DON) error {
+	requestTimeout := time.Duration(remotetypes.DefaultRequestTimeoutMs) * time.Millisecond
+	var caller remoteCallbackCaller
+	switch capInfo.CapabilityType {
+	case commoncap.CapabilityTypeAction:
+		caller = remote.NewRemoteActionCaller(capInfo, capDonInfo, workflowDonInfo, s.dispatcher, nil, requestTimeout, s.lggr)
+	case commoncap.CapabilityTypeConsensus:
+		caller = remote.NewRemoteConsensusCaller(capInfo, capDonInfo, workflowDonInfo, s.dispatcher, nil, requestTimeout, s.lggr)
+	case commoncap.CapabilityTypeTarget:
+		caller = remote.NewRemoteTargetCaller(capInfo, capDonInfo, workflowDonInfo, s.dispatcher, requestTimeout, s.lggr)
+	default:
+		return fmt.Errorf("unsupported remote capability type %s for capability %s", capInfo.CapabilityType, capInfo.ID)
+	}
+	err := s.registry.Add(ctx, caller)
+	if err != nil {
+		s.lggr.Errorw("failed to add remote capability to registry", "capabilityId", capInfo.ID, "error", err)
+		return err
+	}
+	err = s.dispatcher.SetReceiver(capInfo.ID, capDonInfo.ID, caller)
+	if err != nil {
+		s.lggr.Errorw("workflow DON failed to set receiver", "capabilityId", capInfo.ID, "donId", capDonInfo.ID, "error", err)
+		return err
+	}
+	s.subServices = append(s.subServices, caller)
+	return nil
+}
+
+// exposeLocalCapabilityLoop retries exposing a local capability to workflow DONs until it succeeds or the syncer is closed.
+func (s *registrySyncer) exposeLocalCapabilityLoop(capInfo commoncap.CapabilityInfo, capDonInfo capabilities.DON, workflowDONs map[string]capabilities.DON) {
+	defer s.wg.Done()
+	ctx, cancel := s.stopCh.NewCtx()
+	defer cancel()
+	ticker := time.NewTicker(s.retryInterval)
+	defer ticker.Stop()
+	for {
+		err := s.exposeLocalCapability(ctx, capInfo, capDonInfo, workflowDONs)
+		if err == nil {
+			return
+		}
+		s.lggr.Warnw("failed to expose local capability to workflow DONs - retrying", "capabilityId", capInfo.ID, "retryInterval", s.retryInterval, "error", err)
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// exposeLocalCapability starts a receiver wrapping the local capability and routes requests from workflow DONs to it.
+func (s *registrySyncer) exposeLocalCapability(ctx context.Context, capInfo commoncap.CapabilityInfo, capDonInfo capabilities.DON, workflowDONs map[string]capabilities.DON) error {
+	requestTimeout := time.Duration(remotetypes.DefaultRequestTimeoutMs) * time.Millisecond
+	var receiver remoteCallbackReceiver
+	switch capInfo.CapabilityType {
+	case commoncap.CapabilityTypeAction:
+		underlying, err := s.registry.GetAction(ctx, capInfo.ID)
+		if err != nil {
+			return fmt.Errorf("local action capability not found: %w", err)
+		}
+		receiver = remote.NewRemoteActionReceiver(underlying, capInfo, capDonInfo, workflowDONs, s.dispatcher, requestTimeout, s.lggr)
+	case commoncap.CapabilityTypeConsensus:
+		underlying, err := s.registry.GetConsensus(ctx, capInfo.ID)
+		if err != nil {
+			return fmt.Errorf("local consensus capability not found: %w", err)
+		}
+		receiver = remote.NewRemoteConsensusReceiver(underlying, capInfo, capDonInfo, workflowDONs, s.dispatcher, requestTimeout, s.lggr)
+	case commoncap.CapabilityTypeTarget:
+		underlying, err := s.registry.GetTarget(ctx, capInfo.ID)
+		if err != nil {
+			return fmt.Errorf("local target capability not found: %w", err)
+		}
+		receiver = remote.NewRemoteTargetReceiver(underlying, capInfo, capDonInfo, workflowDONs, s.dispatcher, requestTimeout, s.lggr)
+	default:
+		return fmt.Errorf("unsupported remote capability type %s for capability %s", capInfo.CapabilityType, capInfo.ID)
+	}
+	err := receiver.Start(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.dispatcher.SetReceiver(capInfo.ID, capDonInfo.ID, receiver)
+	if err != nil {
+		s.lggr.Errorw("capability DON failed to set receiver", "capabilityId", capInfo.ID, "donId", capDonInfo.ID, "error", err)
+		return errors.Join(err, receiver.Close())
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subServices = append(s.subServices, receiver)
+	s.lggr.Infow("exposed local capability to workflow DONs", "capabilityId", capInfo.ID, "donId", capDonInfo.ID)
+	return nil
+}
+
 func (s *registrySyncer) Close() error {
+	close(s.stopCh)
+	s.wg.Wait()
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	for _, subService := range s.subServices {
 		err := subService.Close()
 		if err != nil {
@@ -226,7 +381,12 @@ func (m *mockMercuryDataProducer) loop() {
 
 	prices := []int64{300000, 40000, 5000000}
 
-	for range ticker.C {
+	for {
+		select {
+		case <-m.closeCh:
+			return
+		case <-ticker.C:
+		}
 		for i := range prices {
 			prices[i] = prices[i] + 1
 		}
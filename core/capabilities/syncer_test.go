@@ -1,13 +1,18 @@
 package capabilities_test
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	ragetypes "github.com/smartcontractkit/libocr/ragep2p/types"
 
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/triggers"
 	commonMocks "github.com/smartcontractkit/chainlink-common/pkg/types/mocks"
 	coreCapabilities "github.com/smartcontractkit/chainlink/v2/core/capabilities"
 	remoteMocks "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types/mocks"
@@ -32,7 +37,113 @@ func TestSyncer_CleanStartClose(t *testing.T) {
 	dispatcher := remoteMocks.NewDispatcher(t)
 	dispatcher.On("SetReceiver", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
-	syncer := coreCapabilities.NewRegistrySyncer(wrapper, registry, dispatcher, lggr)
+	syncer := coreCapabilities.NewRegistrySyncer(wrapper, registry, dispatcher, nil, lggr)
 	require.NoError(t, syncer.Start(ctx))
 	require.NoError(t, syncer.Close())
 }
+
+func TestSyncer_AddsRemoteCallbackCapabilityCaller(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	var pid ragetypes.PeerID
+	// member of the workflow DON
+	err := pid.UnmarshalText([]byte("12D3KooWBCF1XT5Wi8FzfgNCqRL76Swv8TRU3TiD4QiJm8NMNX7N"))
+	require.NoError(t, err)
+	peer := mocks.NewPeer(t)
+	peer.On("UpdateConnections", mock.Anything).Return(nil)
+	peer.On("ID").Return(pid)
+	wrapper := mocks.NewPeerWrapper(t)
+	wrapper.On("GetPeer").Return(peer)
+	registry := commonMocks.NewCapabilitiesRegistry(t)
+	registry.On("Add", mock.Anything, mock.Anything).Return(nil)
+	dispatcher := remoteMocks.NewDispatcher(t)
+	dispatcher.On("SetReceiver", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	callbackCapabilities := []commoncap.CapabilityInfo{{ID: "offchain_reporting", CapabilityType: commoncap.CapabilityTypeConsensus}}
+	syncer := coreCapabilities.NewRegistrySyncer(wrapper, registry, dispatcher, callbackCapabilities, lggr)
+	require.NoError(t, syncer.Start(ctx))
+	require.NoError(t, syncer.Close())
+
+	registry.AssertCalled(t, "Add", mock.Anything, mock.MatchedBy(func(c commoncap.BaseCapability) bool {
+		info, err := c.Info(ctx)
+		return err == nil && info.ID == "offchain_reporting" && info.DON != nil && info.DON.ID == "capabilityDon1"
+	}))
+	dispatcher.AssertCalled(t, "SetReceiver", "offchain_reporting", "capabilityDon1", mock.Anything)
+}
+
+func TestSyncer_RejectsUnsupportedRemoteCallbackCapability(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	var pid ragetypes.PeerID
+	err := pid.UnmarshalText([]byte("12D3KooWBCF1XT5Wi8FzfgNCqRL76Swv8TRU3TiD4QiJm8NMNX7N"))
+	require.NoError(t, err)
+	peer := mocks.NewPeer(t)
+	peer.On("UpdateConnections", mock.Anything).Return(nil)
+	peer.On("ID").Return(pid)
+	wrapper := mocks.NewPeerWrapper(t)
+	wrapper.On("GetPeer").Return(peer)
+	registry := commonMocks.NewCapabilitiesRegistry(t)
+	registry.On("Add", mock.Anything, mock.Anything).Return(nil)
+	dispatcher := remoteMocks.NewDispatcher(t)
+	dispatcher.On("SetReceiver", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	callbackCapabilities := []commoncap.CapabilityInfo{{ID: "streams-trigger", CapabilityType: commoncap.CapabilityTypeTrigger}}
+	syncer := coreCapabilities.NewRegistrySyncer(wrapper, registry, dispatcher, callbackCapabilities, lggr)
+	require.ErrorContains(t, syncer.Start(ctx), "unsupported remote capability type")
+	require.NoError(t, syncer.Close())
+}
+
+func TestSyncer_ExposesLocalCapabilityOnceAvailable(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	var pid ragetypes.PeerID
+	// member of the capability DON
+	err := pid.UnmarshalText([]byte("12D3KooWJrthXtnPHw7xyHFAxo6NxifYTvc8igKYaA6wRRRqtsMb"))
+	require.NoError(t, err)
+	peer := mocks.NewPeer(t)
+	peer.On("UpdateConnections", mock.Anything).Return(nil)
+	peer.On("ID").Return(pid)
+	wrapper := mocks.NewPeerWrapper(t)
+	wrapper.On("GetPeer").Return(peer)
+	registry := commonMocks.NewCapabilitiesRegistry(t)
+	registry.On("Add", mock.Anything, mock.Anything).Return(nil)
+	registry.On("GetTrigger", mock.Anything, "mercury-trigger").Return(triggers.NewMercuryTriggerService(1000, lggr), nil)
+	// the local capability is added to the registry only after the syncer started
+	registry.On("GetConsensus", mock.Anything, "offchain_reporting").Return(nil, errors.New("capability not found")).Once()
+	registry.On("GetConsensus", mock.Anything, "offchain_reporting").Return(&testConsensus{}, nil)
+	dispatcher := remoteMocks.NewDispatcher(t)
+	dispatcher.On("SetReceiver", "mercury-trigger", mock.Anything, mock.Anything).Return(nil)
+	exposed := make(chan struct{})
+	dispatcher.On("SetReceiver", "offchain_reporting", "capabilityDon1", mock.Anything).Return(nil).Run(func(mock.Arguments) {
+		close(exposed)
+	}).Once()
+
+	callbackCapabilities := []commoncap.CapabilityInfo{{ID: "offchain_reporting", CapabilityType: commoncap.CapabilityTypeConsensus}}
+	syncer := coreCapabilities.NewTestRegistrySyncer(wrapper, registry, dispatcher, callbackCapabilities, lggr, 10*time.Millisecond)
+	require.NoError(t, syncer.Start(ctx))
+	select {
+	case <-exposed:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("local capability was not exposed")
+	}
+	require.NoError(t, syncer.Close())
+	registry.AssertNumberOfCalls(t, "GetConsensus", 2)
+}
+
+type testConsensus struct{}
+
+func (c *testConsensus) Info(ctx context.Context) (commoncap.CapabilityInfo, error) {
+	return commoncap.CapabilityInfo{}, nil
+}
+
+func (c *testConsensus) RegisterToWorkflow(ctx context.Context, request commoncap.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (c *testConsensus) UnregisterFromWorkflow(ctx context.Context, request commoncap.UnregisterFromWorkflowRequest) error {
+	return nil
+}
+
+func (c *testConsensus) Execute(ctx context.Context, request commoncap.CapabilityRequest) (<-chan commoncap.CapabilityResponse, error) {
+	return nil, errors.New("not implemented")
+}
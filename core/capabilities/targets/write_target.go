@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -17,6 +18,8 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/metadata"
 	abiutil "github.com/smartcontractkit/chainlink/v2/core/chains/evm/abi"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -42,12 +45,16 @@ var (
 	_ capabilities.ActionCapability = &EvmWrite{}
 )
 
-const defaultGasLimit = 200000
+const (
+	defaultGasLimit       = 200000
+	defaultTxPollInterval = time.Second
+)
 
 type EvmWrite struct {
 	chain legacyevm.Chain
 	capabilities.CapabilityInfo
-	lggr logger.Logger
+	txPollInterval time.Duration
+	lggr           logger.Logger
 }
 
 func NewEvmWrite(chain legacyevm.Chain, lggr logger.Logger) *EvmWrite {
@@ -69,6 +76,7 @@ func NewEvmWrite(chain legacyevm.Chain, lggr logger.Logger) *EvmWrite {
 	return &EvmWrite{
 		chain,
 		info,
+		defaultTxPollInterval,
 		lggr.Named("EvmWrite"),
 	}
 }
@@ -158,9 +166,31 @@ func encodePayload(args []any, rawSelector string) ([]byte, error) {
 	// return append(method.ID, arguments...), nil
 }
 
+func parseSignatures(inputs map[string]any) ([][]byte, error) {
+	rawSignatures, ok := inputs["signatures"]
+	if !ok || rawSignatures == nil {
+		return [][]byte{}, nil
+	}
+	switch v := rawSignatures.(type) {
+	case [][]byte:
+		return v, nil
+	case []any:
+		signatures := make([][]byte, len(v))
+		for i, rawSignature := range v {
+			signature, ok := rawSignature.([]byte)
+			if !ok {
+				return nil, fmt.Errorf("malformed data: signature %d is %T, expected bytes", i, rawSignature)
+			}
+			signatures[i] = signature
+		}
+		return signatures, nil
+	default:
+		return nil, fmt.Errorf("malformed data: signatures is %T, expected a list of bytes", rawSignatures)
+	}
+}
+
 func (cap *EvmWrite) Execute(ctx context.Context, request capabilities.CapabilityRequest) (<-chan capabilities.CapabilityResponse, error) {
 	cap.lggr.Debugw("Execute", "request", request)
-	// TODO: idempotency
 
 	// TODO: extract into ChainWriter?
 	txm := cap.chain.TxManager()
@@ -187,7 +217,6 @@ func (cap *EvmWrite) Execute(ctx context.Context, request capabilities.Capabilit
 		cap.lggr.Debugw("Skipping empty report", "request", request)
 		callback := make(chan capabilities.CapabilityResponse)
 		go func() {
-			// TODO: cast tx.Error to Err (or Value to Value?)
 			callback <- capabilities.CapabilityResponse{
 				Value: nil,
 				Err:   nil,
@@ -210,8 +239,10 @@ func (cap *EvmWrite) Execute(ctx context.Context, request capabilities.Capabilit
 
 	// TODO: validate encoded report is prefixed with workflowID and executionID that match the request meta
 
-	// No signature validation in the MVP demo
-	signatures := [][]byte{}
+	signatures, err := parseSignatures(inputs)
+	if err != nil {
+		return nil, err
+	}
 
 	// construct forwarding payload
 	calldata, err := forwardABI.Pack("report", common.HexToAddress(reqConfig.Address), data, signatures)
@@ -238,6 +269,12 @@ func (cap *EvmWrite) Execute(ctx context.Context, request capabilities.Capabilit
 		Checker:        checker,
 		// SignalCallback:   true, TODO: add code that checks if a workflow id is present, if so, route callback to chainwriter rather than pipeline
 	}
+	if request.Metadata.WorkflowExecutionID != "" {
+		// A repeated request returns the already created transaction instead of sending a second one.
+		// Idempotency keys are unique across chains, and an execution can write to several receivers.
+		idempotencyKey := fmt.Sprintf("%s/%s/%s", metadata.RequestID(request), cap.chain.ID(), common.HexToAddress(reqConfig.Address))
+		req.IdempotencyKey = &idempotencyKey
+	}
 	tx, err := txm.CreateTransaction(ctx, req)
 	if err != nil {
 		return nil, err
@@ -246,16 +283,82 @@ func (cap *EvmWrite) Execute(ctx context.Context, request capabilities.Capabilit
 
 	callback := make(chan capabilities.CapabilityResponse)
 	go func() {
-		// TODO: cast tx.Error to Err (or Value to Value?)
-		callback <- capabilities.CapabilityResponse{
-			Value: nil,
-			Err:   nil,
+		defer close(callback)
+		response := cap.awaitTransaction(ctx, txm, tx.ID)
+		select {
+		case callback <- response:
+		case <-ctx.Done():
 		}
-		close(callback)
 	}()
 	return callback, nil
 }
 
+// awaitTransaction polls the txmgr until the transaction is either confirmed or failed and
+// returns its hash and final status.
+func (cap *EvmWrite) awaitTransaction(ctx context.Context, txm txmgr.TxManager, txID int64) capabilities.CapabilityResponse {
+	for {
+		txs, err := txm.FindTxesWithAttemptsAndReceiptsByIdsAndState(ctx, []int64{txID}, txFinalStates, cap.chain.ID())
+		if err != nil {
+			cap.lggr.Errorw("Failed to look up transaction", "txID", txID, "err", err)
+		} else if len(txs) > 0 {
+			return txResponse(txs[0])
+		}
+
+		select {
+		case <-ctx.Done():
+			return capabilities.CapabilityResponse{Err: fmt.Errorf("transaction %d was not finalized: %w", txID, ctx.Err())}
+		case <-time.After(cap.txPollInterval):
+		}
+	}
+}
+
+// Final states of a transaction after which txmgr doesn't process it anymore. A transaction missing its
+// receipt isn't final, as the receipt can still be found.
+var txFinalStates = []txmgrtypes.TxState{txmgrcommon.TxConfirmed, txmgrcommon.TxFatalError}
+
+const (
+	txStatusConfirmed = "confirmed"
+	txStatusReverted  = "reverted"
+	txStatusFailed    = "failed"
+)
+
+func txResponse(tx *txmgr.Tx) capabilities.CapabilityResponse {
+	var txHash common.Hash
+	var receipt txmgr.ChainReceipt
+	for _, attempt := range tx.TxAttempts {
+		if len(attempt.Receipts) > 0 {
+			txHash = attempt.Hash
+			receipt = attempt.Receipts[0]
+			break
+		}
+	}
+	if receipt == nil && len(tx.TxAttempts) > 0 {
+		txHash = tx.TxAttempts[0].Hash
+	}
+
+	var status string
+	var err error
+	switch {
+	case tx.State == txmgrcommon.TxConfirmed && receipt != nil && receipt.GetStatus() == 0:
+		status = txStatusReverted
+		err = fmt.Errorf("transaction %s reverted", txHash)
+	case tx.State == txmgrcommon.TxConfirmed:
+		status = txStatusConfirmed
+	default:
+		status = txStatusFailed
+		err = fmt.Errorf("transaction %d failed: %s", tx.ID, tx.Error.String)
+	}
+
+	value, wrapErr := values.NewMap(map[string]any{
+		"txHash": txHash.Hex(),
+		"status": status,
+	})
+	if wrapErr != nil {
+		return capabilities.CapabilityResponse{Err: wrapErr}
+	}
+	return capabilities.CapabilityResponse{Value: value, Err: err}
+}
+
 func (cap *EvmWrite) RegisterToWorkflow(ctx context.Context, request capabilities.RegisterToWorkflowRequest) error {
 	return nil
 }
@@ -6,10 +6,14 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/metadata"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/targets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	evmutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/keystone/generated/forwarder"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
@@ -20,6 +24,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 var forwardABI = types.MustGetABI(forwarder.KeystoneForwarderMetaData.ABI)
@@ -48,27 +53,33 @@ func TestEvmWrite(t *testing.T) {
 	capability := targets.NewEvmWrite(chain, logger.TestLogger(t))
 	ctx := testutils.Context(t)
 
+	receiver := testutils.NewAddress()
 	config, err := values.NewMap(map[string]any{
-		"abi":    "receive(report bytes)",
-		"params": []any{"$(report)"},
+		"address": receiver.Hex(),
+		"abi":     "receive(report bytes)",
+		"params":  []any{"$(report)"},
 	})
 	require.NoError(t, err)
 
 	inputs, err := values.NewMap(map[string]any{
-		"report": []byte{1, 2, 3},
+		"report":     []byte{1, 2, 3},
+		"signatures": [][]byte{{4, 5}, {6, 7}},
 	})
 	require.NoError(t, err)
 
 	req := capabilities.CapabilityRequest{
 		Metadata: capabilities.RequestMetadata{
-			WorkflowID: "hello",
+			WorkflowID:          "hello",
+			WorkflowExecutionID: "hello_execution",
 		},
-		Config: config,
+		Config: metadata.WithRequestID(config, metadata.NewRequestID("hello_execution", "write", -1, 0)),
 		Inputs: inputs,
 	}
 
-	txManager.On("CreateTransaction", mock.Anything, mock.Anything).Return(txmgr.Tx{}, nil).Run(func(args mock.Arguments) {
+	txManager.On("CreateTransaction", mock.Anything, mock.Anything).Return(txmgr.Tx{ID: 1}, nil).Run(func(args mock.Arguments) {
 		req := args.Get(1).(txmgr.TxRequest)
+		require.NotNil(t, req.IdempotencyKey)
+		require.Equal(t, "hello_execution/write/11155111/"+receiver.Hex(), *req.IdempotencyKey)
 		payload := make(map[string]any)
 		method := forwardABI.Methods["report"]
 		err = method.Inputs.UnpackIntoMap(payload, req.EncodedPayload[4:])
@@ -79,13 +90,92 @@ func TestEvmWrite(t *testing.T) {
 			0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3, // len = 3
 			0x1, 0x2, 0x3, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, // elements [1, 2, 3] zero padded
 		}, payload["data"])
+		require.Equal(t, [][]byte{{4, 5}, {6, 7}}, payload["signatures"])
 	})
 
+	txHash := evmutils.NewHash()
+	finalStates := []txmgrtypes.TxState{txmgrcommon.TxConfirmed, txmgrcommon.TxFatalError}
+	txManager.On("FindTxesWithAttemptsAndReceiptsByIdsAndState", mock.Anything, []int64{1}, finalStates, mock.Anything).Return([]*txmgr.Tx{
+		{
+			ID:    1,
+			State: txmgrcommon.TxConfirmed,
+			TxAttempts: []txmgr.TxAttempt{
+				{Hash: txHash, Receipts: []txmgr.ChainReceipt{&types.Receipt{TxHash: txHash, Status: 1}}},
+			},
+		},
+	}, nil)
+
 	ch, err := capability.Execute(ctx, req)
 	require.NoError(t, err)
 
 	response := <-ch
 	require.Nil(t, response.Err)
+	result, err := response.Value.Unwrap()
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"txHash": txHash.Hex(), "status": "confirmed"}, result)
+}
+
+func TestEvmWrite_FailedTransaction(t *testing.T) {
+	chain := evmmocks.NewChain(t)
+
+	txManager := txmmocks.NewMockEvmTxManager(t)
+	chain.On("ID").Return(big.NewInt(11155111))
+	chain.On("TxManager").Return(txManager)
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		a := testutils.NewAddress()
+		addr, err := types.NewEIP55Address(a.Hex())
+		require.NoError(t, err)
+		c.EVM[0].ChainWriter.FromAddress = &addr
+
+		forwarderA := testutils.NewAddress()
+		forwarderAddr, err := types.NewEIP55Address(forwarderA.Hex())
+		require.NoError(t, err)
+		c.EVM[0].ChainWriter.ForwarderAddress = &forwarderAddr
+	})
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	chain.On("Config").Return(evmcfg)
+
+	capability := targets.NewEvmWrite(chain, logger.TestLogger(t))
+	ctx := testutils.Context(t)
+
+	config, err := values.NewMap(map[string]any{
+		"abi":    "receive(report bytes)",
+		"params": []any{"$(report)"},
+	})
+	require.NoError(t, err)
+
+	inputs, err := values.NewMap(map[string]any{
+		"report": []byte{1, 2, 3},
+	})
+	require.NoError(t, err)
+
+	req := capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{
+			WorkflowID:          "hello",
+			WorkflowExecutionID: "hello_execution",
+		},
+		Config: config,
+		Inputs: inputs,
+	}
+
+	txManager.On("CreateTransaction", mock.Anything, mock.Anything).Return(txmgr.Tx{ID: 1}, nil)
+	txManager.On("FindTxesWithAttemptsAndReceiptsByIdsAndState", mock.Anything, []int64{1}, mock.Anything, mock.Anything).Return([]*txmgr.Tx{
+		{
+			ID:    1,
+			State: txmgrcommon.TxFatalError,
+			Error: null.StringFrom("insufficient funds"),
+		},
+	}, nil)
+
+	ch, err := capability.Execute(ctx, req)
+	require.NoError(t, err)
+
+	response := <-ch
+	require.ErrorContains(t, response.Err, "insufficient funds")
+	result, err := response.Value.Unwrap()
+	require.NoError(t, err)
+	require.Equal(t, "failed", result.(map[string]any)["status"])
 }
 
 func TestEvmWrite_EmptyReport(t *testing.T) {
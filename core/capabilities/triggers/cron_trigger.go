@@ -0,0 +1,194 @@
+package triggers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	robfigcron "github.com/robfig/cron/v3"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/cron"
+)
+
+var cronInfo = capabilities.MustNewCapabilityInfo(
+	"cron-trigger",
+	capabilities.CapabilityTypeTrigger,
+	"A trigger that fires on a cron schedule.",
+	"v1.0.0",
+	nil,
+)
+
+type cronConfig struct {
+	// Schedule uses the same syntax as cron jobs, e.g. "CRON_TZ=UTC 0 */5 * * * *" or "@every 1m".
+	Schedule string `json:"schedule"`
+}
+
+type triggerInputs struct {
+	TriggerID string `json:"triggerId"`
+}
+
+var cronTriggerValidator = capabilities.NewValidator[cronConfig, triggerInputs, capabilities.TriggerEvent](capabilities.ValidatorArgs{Info: cronInfo})
+
+// CronTrigger sends an event to each registered workflow whenever its schedule fires.
+type CronTrigger struct {
+	services.StateMachine
+	capabilities.Validator[cronConfig, triggerInputs, capabilities.TriggerEvent]
+	capabilities.CapabilityInfo
+	clock       clockwork.Clock
+	subscribers map[string]*cronSubscriber
+	mu          sync.Mutex
+	stopCh      services.StopChan
+	wg          sync.WaitGroup
+	lggr        logger.Logger
+}
+
+var _ capabilities.TriggerCapability = (*CronTrigger)(nil)
+var _ services.Service = (*CronTrigger)(nil)
+
+type cronSubscriber struct {
+	ch         chan capabilities.CapabilityResponse
+	workflowID string
+	schedule   robfigcron.Schedule
+	stopCh     services.StopChan
+}
+
+func NewCronTrigger(clock clockwork.Clock, lggr logger.Logger) *CronTrigger {
+	return &CronTrigger{
+		Validator:      cronTriggerValidator,
+		CapabilityInfo: cronInfo,
+		clock:          clock,
+		subscribers:    map[string]*cronSubscriber{},
+		stopCh:         make(services.StopChan),
+		lggr:           lggr.Named("CronTrigger"),
+	}
+}
+
+func (c *CronTrigger) RegisterTrigger(ctx context.Context, req capabilities.CapabilityRequest) (<-chan capabilities.CapabilityResponse, error) {
+	wid := req.Metadata.WorkflowID
+
+	config, err := c.ValidateConfig(req.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, err := c.ValidateInputs(req.Inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := cron.ParseSchedule(config.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	triggerID := getTriggerID(inputs.TriggerID, wid)
+	if _, ok := c.subscribers[triggerID]; ok {
+		return nil, fmt.Errorf("triggerId %s already registered", triggerID)
+	}
+
+	sub := &cronSubscriber{
+		ch:         make(chan capabilities.CapabilityResponse, defaultSendChannelBufferSize),
+		workflowID: wid,
+		schedule:   schedule,
+		stopCh:     make(services.StopChan),
+	}
+	c.subscribers[triggerID] = sub
+
+	c.wg.Add(1)
+	go c.run(triggerID, sub)
+
+	return sub.ch, nil
+}
+
+func (c *CronTrigger) UnregisterTrigger(ctx context.Context, req capabilities.CapabilityRequest) error {
+	wid := req.Metadata.WorkflowID
+
+	inputs, err := c.ValidateInputs(req.Inputs)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	triggerID := getTriggerID(inputs.TriggerID, wid)
+	sub, ok := c.subscribers[triggerID]
+	if !ok {
+		return fmt.Errorf("triggerId %s not registered", triggerID)
+	}
+
+	// The subscriber's goroutine closes its channel once it has stopped sending.
+	close(sub.stopCh)
+	delete(c.subscribers, triggerID)
+	return nil
+}
+
+// run sends an event to the subscriber every time its schedule fires,
+// until either the subscriber or the trigger is stopped.
+func (c *CronTrigger) run(triggerID string, sub *cronSubscriber) {
+	defer c.wg.Done()
+	defer close(sub.ch)
+
+	for {
+		now := c.clock.Now()
+		next := sub.schedule.Next(now)
+		select {
+		case <-c.stopCh:
+			return
+		case <-sub.stopCh:
+			return
+		case <-c.clock.After(next.Sub(now)):
+			c.send(triggerID, sub, next)
+		}
+	}
+}
+
+func (c *CronTrigger) send(triggerID string, sub *cronSubscriber, scheduled time.Time) {
+	// use 32-byte-padded timestamp as EventID (human-readable)
+	eventID := fmt.Sprintf("cron_%027s", strconv.FormatInt(scheduled.Unix(), 10))
+	resp, err := wrapTriggerEvent("cron", eventID, scheduled, map[string]any{
+		"scheduledExecutionTime": scheduled.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		c.lggr.Errorw("error wrapping cron trigger event", "err", err, "triggerID", triggerID)
+		return
+	}
+
+	select {
+	case sub.ch <- resp:
+	default:
+		c.lggr.Errorw("subscriber channel full, dropping event", "eventID", eventID, "workflowID", sub.workflowID)
+	}
+}
+
+func (c *CronTrigger) Start(ctx context.Context) error {
+	return c.StartOnce("CronTrigger", func() error {
+		return nil
+	})
+}
+
+func (c *CronTrigger) Close() error {
+	return c.StopOnce("CronTrigger", func() error {
+		close(c.stopCh)
+		c.wg.Wait()
+		return nil
+	})
+}
+
+func (c *CronTrigger) Name() string {
+	return c.lggr.Name()
+}
+
+func (c *CronTrigger) HealthReport() map[string]error {
+	return map[string]error{c.Name(): c.Healthy()}
+}
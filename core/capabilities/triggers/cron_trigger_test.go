@@ -0,0 +1,69 @@
+package triggers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func newTriggerRequest(t *testing.T, workflowID string, config map[string]any) capabilities.CapabilityRequest {
+	inputs, err := values.NewMap(map[string]any{"triggerId": "trigger-1"})
+	require.NoError(t, err)
+	cfg, err := values.NewMap(config)
+	require.NoError(t, err)
+	return capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{WorkflowID: workflowID},
+		Inputs:   inputs,
+		Config:   cfg,
+	}
+}
+
+func TestCronTrigger(t *testing.T) {
+	ctx := testutils.Context(t)
+	start := time.Date(2024, 5, 1, 12, 0, 30, 0, time.UTC)
+	clock := clockwork.NewFakeClockAt(start)
+	trigger := NewCronTrigger(clock, logger.TestLogger(t))
+	require.NoError(t, trigger.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, trigger.Close()) })
+
+	_, err := trigger.RegisterTrigger(ctx, newTriggerRequest(t, "workflow-1", map[string]any{"schedule": "0 * * * * *"}))
+	require.ErrorContains(t, err, "must specify a time zone")
+
+	req := newTriggerRequest(t, "workflow-1", map[string]any{"schedule": "CRON_TZ=UTC 0 * * * * *"})
+	ch, err := trigger.RegisterTrigger(ctx, req)
+	require.NoError(t, err)
+
+	_, err = trigger.RegisterTrigger(ctx, req)
+	require.ErrorContains(t, err, "already registered")
+
+	for i := 1; i <= 2; i++ {
+		clock.BlockUntil(1)
+		clock.Advance(time.Minute)
+
+		resp := <-ch
+		te := capabilities.TriggerEvent{}
+		require.NoError(t, resp.Value.UnwrapTo(&te))
+		scheduled := start.Truncate(time.Minute).Add(time.Duration(i) * time.Minute)
+		assert.Equal(t, "cron", te.TriggerType)
+		assert.Len(t, te.ID, 32)
+
+		payload, err := values.Unwrap(te.Payload)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"scheduledExecutionTime": scheduled.Format(time.RFC3339)}, payload)
+	}
+
+	require.NoError(t, trigger.UnregisterTrigger(ctx, req))
+	_, ok := <-ch
+	assert.False(t, ok)
+
+	require.ErrorContains(t, trigger.UnregisterTrigger(ctx, req), "not registered")
+}
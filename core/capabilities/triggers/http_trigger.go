@@ -0,0 +1,144 @@
+package triggers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var httpInfo = capabilities.MustNewCapabilityInfo(
+	"http-trigger",
+	capabilities.CapabilityTypeTrigger,
+	"A trigger that fires when a payload is posted to the node's web server.",
+	"v1.0.0",
+	nil,
+)
+
+type httpConfig struct{}
+
+var httpTriggerValidator = capabilities.NewValidator[httpConfig, triggerInputs, capabilities.TriggerEvent](capabilities.ValidatorArgs{Info: httpInfo})
+
+var (
+	// ErrWorkflowNotRegistered is returned when sending an event to a workflow which hasn't registered the HTTP trigger.
+	ErrWorkflowNotRegistered = errors.New("workflow has not registered an http trigger")
+	// ErrSubscriberFull is returned when a subscriber's buffer is full and the event was dropped.
+	ErrSubscriberFull = errors.New("http trigger event not sent as send buffer is full")
+)
+
+// HTTPTrigger sends payloads received by the web server to the workflows that registered it.
+type HTTPTrigger struct {
+	capabilities.Validator[httpConfig, triggerInputs, capabilities.TriggerEvent]
+	capabilities.CapabilityInfo
+	clock       clockwork.Clock
+	subscribers map[string]*httpSubscriber
+	mu          sync.Mutex
+	lggr        logger.Logger
+}
+
+var _ capabilities.TriggerCapability = (*HTTPTrigger)(nil)
+
+type httpSubscriber struct {
+	ch         chan capabilities.CapabilityResponse
+	workflowID string
+}
+
+func NewHTTPTrigger(clock clockwork.Clock, lggr logger.Logger) *HTTPTrigger {
+	return &HTTPTrigger{
+		Validator:      httpTriggerValidator,
+		CapabilityInfo: httpInfo,
+		clock:          clock,
+		subscribers:    map[string]*httpSubscriber{},
+		lggr:           lggr.Named("HTTPTrigger"),
+	}
+}
+
+func (h *HTTPTrigger) RegisterTrigger(ctx context.Context, req capabilities.CapabilityRequest) (<-chan capabilities.CapabilityResponse, error) {
+	wid := req.Metadata.WorkflowID
+
+	if _, err := h.ValidateConfig(req.Config); err != nil {
+		return nil, err
+	}
+
+	inputs, err := h.ValidateInputs(req.Inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	triggerID := getTriggerID(inputs.TriggerID, wid)
+	if _, ok := h.subscribers[triggerID]; ok {
+		return nil, fmt.Errorf("triggerId %s already registered", triggerID)
+	}
+
+	ch := make(chan capabilities.CapabilityResponse, defaultSendChannelBufferSize)
+	h.subscribers[triggerID] = &httpSubscriber{ch: ch, workflowID: wid}
+	return ch, nil
+}
+
+func (h *HTTPTrigger) UnregisterTrigger(ctx context.Context, req capabilities.CapabilityRequest) error {
+	wid := req.Metadata.WorkflowID
+
+	inputs, err := h.ValidateInputs(req.Inputs)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	triggerID := getTriggerID(inputs.TriggerID, wid)
+	sub, ok := h.subscribers[triggerID]
+	if !ok {
+		return fmt.Errorf("triggerId %s not registered", triggerID)
+	}
+	close(sub.ch)
+	delete(h.subscribers, triggerID)
+	return nil
+}
+
+// SendEvent sends the payload to every registration of the HTTP trigger by the given workflow,
+// and returns the ID of the resulting event.
+func (h *HTTPTrigger) SendEvent(ctx context.Context, workflowID string, payload map[string]any) (string, error) {
+	eventID := "http_" + uuid.NewString()
+	resp, err := wrapTriggerEvent("http", eventID, h.clock.Now(), payload)
+	if err != nil {
+		return "", fmt.Errorf("invalid payload: %w", err)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var registered, dropped bool
+	for _, sub := range h.subscribers {
+		if sub.workflowID != workflowID {
+			continue
+		}
+		registered = true
+		select {
+		case sub.ch <- resp:
+		default:
+			h.lggr.Errorw("subscriber channel full, dropping event", "eventID", eventID, "workflowID", workflowID)
+			dropped = true
+		}
+	}
+
+	if !registered {
+		return "", ErrWorkflowNotRegistered
+	}
+	if dropped {
+		return "", ErrSubscriberFull
+	}
+
+	h.lggr.Debugw("sent http trigger event", "eventID", eventID, "workflowID", workflowID)
+	return eventID, nil
+}
@@ -0,0 +1,58 @@
+package triggers
+
+import (
+	"testing"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestHTTPTrigger(t *testing.T) {
+	ctx := testutils.Context(t)
+	trigger := NewHTTPTrigger(clockwork.NewFakeClock(), logger.TestLogger(t))
+
+	_, err := trigger.SendEvent(ctx, "workflow-1", map[string]any{"price": 100})
+	require.ErrorIs(t, err, ErrWorkflowNotRegistered)
+
+	req := newTriggerRequest(t, "workflow-1", map[string]any{})
+	ch, err := trigger.RegisterTrigger(ctx, req)
+	require.NoError(t, err)
+
+	_, err = trigger.RegisterTrigger(ctx, req)
+	require.ErrorContains(t, err, "already registered")
+
+	// events are only sent to the workflow they're addressed to
+	other, err := trigger.RegisterTrigger(ctx, newTriggerRequest(t, "workflow-2", map[string]any{}))
+	require.NoError(t, err)
+
+	eventID, err := trigger.SendEvent(ctx, "workflow-1", map[string]any{"price": 100})
+	require.NoError(t, err)
+
+	resp := <-ch
+	te := capabilities.TriggerEvent{}
+	require.NoError(t, resp.Value.UnwrapTo(&te))
+	assert.Equal(t, "http", te.TriggerType)
+	assert.Equal(t, eventID, te.ID)
+	payload, err := values.Unwrap(te.Payload)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"price": int64(100)}, payload)
+	assert.Empty(t, other)
+
+	for i := 0; i < defaultSendChannelBufferSize; i++ {
+		_, err = trigger.SendEvent(ctx, "workflow-1", map[string]any{})
+		require.NoError(t, err)
+	}
+	_, err = trigger.SendEvent(ctx, "workflow-1", map[string]any{})
+	require.ErrorIs(t, err, ErrSubscriberFull)
+
+	require.NoError(t, trigger.UnregisterTrigger(ctx, req))
+	_, err = trigger.SendEvent(ctx, "workflow-1", map[string]any{})
+	require.ErrorIs(t, err, ErrWorkflowNotRegistered)
+}
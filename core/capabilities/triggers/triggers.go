@@ -0,0 +1,40 @@
+package triggers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+)
+
+// defaultSendChannelBufferSize is the number of events buffered per subscriber
+// before new events are dropped.
+const defaultSendChannelBufferSize = 1000
+
+func getTriggerID(triggerID string, workflowID string) string {
+	return workflowID + "|" + triggerID
+}
+
+func wrapTriggerEvent(triggerType string, eventID string, timestamp time.Time, payload any) (capabilities.CapabilityResponse, error) {
+	val, err := values.Wrap(payload)
+	if err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+
+	triggerEvent := capabilities.TriggerEvent{
+		TriggerType: triggerType,
+		ID:          eventID,
+		Timestamp:   strconv.FormatInt(timestamp.UnixMilli(), 10),
+		Payload:     val,
+	}
+
+	eventVal, err := values.Wrap(triggerEvent)
+	if err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+
+	return capabilities.CapabilityResponse{
+		Value: eventVal,
+	}, nil
+}
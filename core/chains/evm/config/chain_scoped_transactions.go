@@ -33,3 +33,23 @@ func (t *transactionsConfig) MaxInFlight() uint32 {
 func (t *transactionsConfig) MaxQueued() uint64 {
 	return uint64(*t.c.MaxQueued)
 }
+
+func (t *transactionsConfig) AutoPurge() AutoPurgeConfig {
+	return &autoPurgeConfig{c: t.c.AutoPurge}
+}
+
+type autoPurgeConfig struct {
+	c toml.AutoPurgeConfig
+}
+
+func (a *autoPurgeConfig) Enabled() bool {
+	return *a.c.Enabled
+}
+
+func (a *autoPurgeConfig) Threshold() uint32 {
+	return *a.c.Threshold
+}
+
+func (a *autoPurgeConfig) MinAttempts() uint32 {
+	return *a.c.MinAttempts
+}
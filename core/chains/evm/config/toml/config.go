@@ -388,6 +388,16 @@ func (c *Chain) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "GasEstimator.BumpTxDepth", Value: *c.GasEstimator.BumpTxDepth,
 			Msg: "must be less than or equal to Transactions.MaxInFlight"})
 	}
+	if *c.Transactions.AutoPurge.Enabled {
+		if *c.Transactions.AutoPurge.Threshold < 1 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Transactions.AutoPurge.Threshold", Value: *c.Transactions.AutoPurge.Threshold,
+				Msg: "must be greater than or equal to 1 when AutoPurge is enabled"})
+		}
+		if *c.Transactions.AutoPurge.MinAttempts < 1 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Transactions.AutoPurge.MinAttempts", Value: *c.Transactions.AutoPurge.MinAttempts,
+				Msg: "must be greater than or equal to 1 when AutoPurge is enabled"})
+		}
+	}
 	if *c.HeadTracker.HistoryDepth < *c.FinalityDepth {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "HeadTracker.HistoryDepth", Value: *c.HeadTracker.HistoryDepth,
 			Msg: "must be equal to or greater than FinalityDepth"})
@@ -411,6 +421,8 @@ type Transactions struct {
 	ReaperInterval       *commonconfig.Duration
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
+
+	AutoPurge AutoPurgeConfig `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -432,6 +444,25 @@ func (t *Transactions) setFrom(f *Transactions) {
 	if v := f.ResendAfterThreshold; v != nil {
 		t.ResendAfterThreshold = v
 	}
+	t.AutoPurge.setFrom(&f.AutoPurge)
+}
+
+type AutoPurgeConfig struct {
+	Enabled     *bool
+	Threshold   *uint32
+	MinAttempts *uint32
+}
+
+func (a *AutoPurgeConfig) setFrom(f *AutoPurgeConfig) {
+	if v := f.Enabled; v != nil {
+		a.Enabled = v
+	}
+	if v := f.Threshold; v != nil {
+		a.Threshold = v
+	}
+	if v := f.MinAttempts; v != nil {
+		a.MinAttempts = v
+	}
 }
 
 type OCR2 struct {
@@ -46,6 +46,10 @@ func (disabled) HasFilter(name string) bool { return false }
 
 func (disabled) GetFilters() map[string]Filter { return nil }
 
+func (disabled) FilterCreatedAt(ctx context.Context, name string, address common.Address, eventSig common.Hash) (time.Time, error) {
+	return time.Time{}, ErrDisabled
+}
+
 func (disabled) LatestBlock(ctx context.Context) (LogPollerBlock, error) {
 	return LogPollerBlock{}, ErrDisabled
 }
@@ -42,6 +42,7 @@ type LogPoller interface {
 	UnregisterFilter(ctx context.Context, name string) error
 	HasFilter(name string) bool
 	GetFilters() map[string]Filter
+	FilterCreatedAt(ctx context.Context, name string, address common.Address, eventSig common.Hash) (time.Time, error)
 	LatestBlock(ctx context.Context) (LogPollerBlock, error)
 	GetBlocksRange(ctx context.Context, numbers []uint64) ([]LogPollerBlock, error)
 	FindLCA(ctx context.Context) (*LogPollerBlock, error)
@@ -1153,6 +1154,12 @@ func (lp *logPoller) IndexedLogsTopicRange(ctx context.Context, eventSig common.
 	return lp.orm.SelectIndexedLogsTopicRange(ctx, address, eventSig, topicIndex, topicValueMin, topicValueMax, confs)
 }
 
+// FilterCreatedAt returns when the event of the address was added to the filter with the given name. The log
+// poller only has the logs of the event from the blocks it processed since then, unless it was replayed.
+func (lp *logPoller) FilterCreatedAt(ctx context.Context, name string, address common.Address, eventSig common.Hash) (time.Time, error) {
+	return lp.orm.SelectFilterCreatedAt(ctx, name, address, eventSig)
+}
+
 // LatestBlock returns the latest block the log poller is on. It tracks blocks to be able
 // to detect reorgs.
 func (lp *logPoller) LatestBlock(ctx context.Context) (LogPollerBlock, error) {
@@ -59,6 +59,34 @@ func (_m *LogPoller) DeleteLogsAndBlocksAfter(ctx context.Context, start int64)
 	return r0
 }
 
+// FilterCreatedAt provides a mock function with given fields: ctx, name, address, eventSig
+func (_m *LogPoller) FilterCreatedAt(ctx context.Context, name string, address common.Address, eventSig common.Hash) (time.Time, error) {
+	ret := _m.Called(ctx, name, address, eventSig)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterCreatedAt")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, common.Address, common.Hash) (time.Time, error)); ok {
+		return rf(ctx, name, address, eventSig)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, common.Address, common.Hash) time.Time); ok {
+		r0 = rf(ctx, name, address, eventSig)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, common.Address, common.Hash) error); ok {
+		r1 = rf(ctx, name, address, eventSig)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FilteredLogs provides a mock function with given fields: filter, limitAndSrt
 func (_m *LogPoller) FilteredLogs(filter query.KeyFilter, limitAndSrt query.LimitAndSort) ([]logpoller.Log, error) {
 	ret := _m.Called(filter, limitAndSrt)
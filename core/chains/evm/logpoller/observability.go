@@ -117,6 +117,12 @@ func (o *ObservedORM) LoadFilters(ctx context.Context) (map[string]Filter, error
 	})
 }
 
+func (o *ObservedORM) SelectFilterCreatedAt(ctx context.Context, name string, address common.Address, eventSig common.Hash) (time.Time, error) {
+	return withObservedQuery(o, "SelectFilterCreatedAt", func() (time.Time, error) {
+		return o.ORM.SelectFilterCreatedAt(ctx, name, address, eventSig)
+	})
+}
+
 func (o *ObservedORM) DeleteFilter(ctx context.Context, name string) error {
 	return withObservedExec(o, "DeleteFilter", del, func() error {
 		return o.ORM.DeleteFilter(ctx, name)
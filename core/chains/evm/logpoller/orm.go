@@ -31,6 +31,7 @@ type ORM interface {
 
 	LoadFilters(ctx context.Context) (map[string]Filter, error)
 	DeleteFilter(ctx context.Context, name string) error
+	SelectFilterCreatedAt(ctx context.Context, name string, address common.Address, eventSig common.Hash) (time.Time, error)
 
 	InsertBlock(ctx context.Context, blockHash common.Hash, blockNumber int64, blockTimestamp time.Time, finalizedBlock int64) error
 	DeleteBlocksBefore(ctx context.Context, end int64, limit int64) (int64, error)
@@ -161,6 +162,15 @@ func (o *DSORM) DeleteFilter(ctx context.Context, name string) error {
 	return err
 }
 
+// SelectFilterCreatedAt returns when the event of the address was added to the filter
+func (o *DSORM) SelectFilterCreatedAt(ctx context.Context, name string, address common.Address, eventSig common.Hash) (time.Time, error) {
+	var createdAt time.Time
+	err := o.ds.GetContext(ctx, &createdAt,
+		`SELECT created_at FROM evm.log_poller_filters WHERE name = $1 AND evm_chain_id = $2 AND address = $3 AND event = $4 ORDER BY created_at LIMIT 1`,
+		name, ubig.New(o.chainID), address.Bytes(), eventSig.Bytes())
+	return createdAt, err
+}
+
 // LoadFilters returns all filters for this chain
 func (o *DSORM) LoadFilters(ctx context.Context) (map[string]Filter, error) {
 	query := `SELECT name,
@@ -585,6 +585,31 @@ func insertLogsTopicValueRange(t *testing.T, chainID *big.Int, o logpoller.ORM,
 	require.NoError(t, o.InsertLogs(testutils.Context(t), lgs))
 }
 
+func TestORM_SelectFilterCreatedAt(t *testing.T) {
+	th := SetupTH(t, lpOpts)
+	o1 := th.ORM
+	ctx := testutils.Context(t)
+	event1 := EmitterABI.Events["Log1"].ID
+	event2 := EmitterABI.Events["Log2"].ID
+	address := common.HexToAddress("0x1234")
+
+	_, err := o1.SelectFilterCreatedAt(ctx, "filter", address, event1)
+	require.Equal(t, sql.ErrNoRows, err)
+
+	require.NoError(t, o1.InsertFilter(ctx, logpoller.Filter{Name: "filter", Addresses: types.AddressArray{address}, EventSigs: types.HashArray{event1}}))
+	createdAt1, err := o1.SelectFilterCreatedAt(ctx, "filter", address, event1)
+	require.NoError(t, err)
+
+	// adding an event to the filter doesn't change when the other events were added
+	require.NoError(t, o1.InsertFilter(ctx, logpoller.Filter{Name: "filter", Addresses: types.AddressArray{address}, EventSigs: types.HashArray{event1, event2}}))
+	createdAt, err := o1.SelectFilterCreatedAt(ctx, "filter", address, event1)
+	require.NoError(t, err)
+	assert.True(t, createdAt1.Equal(createdAt))
+	createdAt2, err := o1.SelectFilterCreatedAt(ctx, "filter", address, event2)
+	require.NoError(t, err)
+	assert.False(t, createdAt2.Before(createdAt1))
+}
+
 func TestORM_IndexedLogs(t *testing.T) {
 	th := SetupTH(t, lpOpts)
 	o1 := th.ORM
@@ -56,8 +56,12 @@ func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr log
 // NewTxAttemptWithType builds a new attempt with a new fee estimation where the txType can be specified by the caller
 // used for L2 re-estimation on broadcasting (note EIP1559 must be disabled otherwise this will fail with mismatched fees + tx type)
 func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx, lggr logger.Logger, txType int, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
-	keySpecificMaxGasPriceWei := c.feeConfig.PriceMaxKey(etx.FromAddress)
-	fee, feeLimit, err = c.EvmFeeEstimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, opts...)
+	feePolicy, err := etx.GetFeePolicy()
+	if err != nil {
+		return attempt, fee, feeLimit, false, pkgerrors.Wrap(err, "failed to get fee policy")
+	}
+	maxGasPriceWei := maxGasPrice(c.feeConfig, etx.FromAddress, feePolicy)
+	fee, feeLimit, err = c.EvmFeeEstimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, maxGasPriceWei, opts...)
 	if err != nil {
 		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get fee") // estimator errors are retryable
 	}
@@ -69,17 +73,49 @@ func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx,
 // NewBumpTxAttempt builds a new attempt with a bumped fee - based on the previous attempt tx type
 // used in the txm broadcaster + confirmer when tx ix rejected for too low fee or is not included in a timely manner
 func (c *evmTxAttemptBuilder) NewBumpTxAttempt(ctx context.Context, etx Tx, previousAttempt TxAttempt, priorAttempts []TxAttempt, lggr logger.Logger) (attempt TxAttempt, bumpedFee gas.EvmFee, bumpedFeeLimit uint64, retryable bool, err error) {
-	keySpecificMaxGasPriceWei := c.feeConfig.PriceMaxKey(etx.FromAddress)
+	feePolicy, err := etx.GetFeePolicy()
+	if err != nil {
+		return attempt, bumpedFee, bumpedFeeLimit, false, pkgerrors.Wrap(err, "failed to get fee policy")
+	}
+	maxGasPriceWei := maxGasPrice(c.feeConfig, etx.FromAddress, feePolicy)
 
-	bumpedFee, bumpedFeeLimit, err = c.EvmFeeEstimator.BumpFee(ctx, previousAttempt.TxFee, etx.FeeLimit, keySpecificMaxGasPriceWei, newEvmPriorAttempts(priorAttempts))
+	bumpedFee, bumpedFeeLimit, err = c.EvmFeeEstimator.BumpFee(ctx, previousAttempt.TxFee, etx.FeeLimit, maxGasPriceWei, newEvmPriorAttempts(priorAttempts))
 	if err != nil {
 		return attempt, bumpedFee, bumpedFeeLimit, true, pkgerrors.Wrap(err, "failed to bump fee") // estimator errors are retryable
 	}
+	if feePolicy != nil && feePolicy.BumpPercent != nil {
+		bumpedFee = bumpFeeByPercent(previousAttempt.TxFee, bumpedFee, *feePolicy.BumpPercent, maxGasPriceWei)
+	}
 
 	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, bumpedFeeLimit, previousAttempt.TxType, lggr)
 	return attempt, bumpedFee, bumpedFeeLimit, retryable, err
 }
 
+// maxGasPrice returns the max gas price of the key, lowered to the max fee price of the fee policy if it has one
+func maxGasPrice(feeConfig evmTxAttemptBuilderFeeConfig, fromAddress common.Address, feePolicy *txmgrtypes.TxFeePolicy) *assets.Wei {
+	maxGasPriceWei := feeConfig.PriceMaxKey(fromAddress)
+	if feePolicy != nil && feePolicy.MaxFeePrice != nil {
+		maxGasPriceWei = assets.WeiMin(maxGasPriceWei, assets.NewWei(feePolicy.MaxFeePrice))
+	}
+	return maxGasPriceWei
+}
+
+// bumpFeeByPercent raises the fee bumped by the estimator to at least percent above the previous fee, without
+// exceeding the max gas price. The estimator has already capped its own bump, so it is never lowered.
+func bumpFeeByPercent(previousFee, bumpedFee gas.EvmFee, percent uint16, maxGasPriceWei *assets.Wei) gas.EvmFee {
+	bumpBy := func(previous, bumped *assets.Wei) *assets.Wei {
+		return assets.WeiMax(bumped, assets.WeiMin(previous.AddPercentage(percent), maxGasPriceWei))
+	}
+	if bumpedFee.Legacy != nil && previousFee.Legacy != nil {
+		bumpedFee.Legacy = bumpBy(previousFee.Legacy, bumpedFee.Legacy)
+	}
+	if bumpedFee.ValidDynamic() && previousFee.ValidDynamic() {
+		bumpedFee.DynamicFeeCap = bumpBy(previousFee.DynamicFeeCap, bumpedFee.DynamicFeeCap)
+		bumpedFee.DynamicTipCap = assets.WeiMin(bumpBy(previousFee.DynamicTipCap, bumpedFee.DynamicTipCap), bumpedFee.DynamicFeeCap)
+	}
+	return bumpedFee
+}
+
 // NewCustomTxAttempt is the lowest level func where the fee parameters + tx type must be passed in
 // used in the txm for force rebroadcast where fees and tx type are pre-determined without an estimator
 func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, gasLimit uint64, txType int, lggr logger.Logger) (attempt TxAttempt, retryable bool, err error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	gasmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
@@ -284,3 +285,71 @@ func TestTxm_EvmTxAttemptBuilder_RetryableEstimatorError(t *testing.T) {
 		assert.True(t, retryable)
 	})
 }
+
+func TestTxm_EvmTxAttemptBuilder_FeePolicy(t *testing.T) {
+	t.Parallel()
+
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(types.NewTx(&types.LegacyTx{}), nil)
+	lggr := logger.Test(t)
+	ctx := testutils.Context(t)
+	gc := newFeeConfig()
+	gc.priceMax = assets.NewWeiI(1000)
+
+	newTx := func(t *testing.T, policy string) txmgr.Tx {
+		var n evmtypes.Nonce
+		feePolicy := sqlutil.JSON(policy)
+		return txmgr.Tx{Sequence: &n, FromAddress: addr, FeeLimit: 100, FeePolicy: &feePolicy}
+	}
+
+	t.Run("caps the fee price at the max fee price of the policy", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFee", mock.Anything, mock.Anything, uint64(100), assets.NewWeiI(500)).Return(gas.EvmFee{Legacy: assets.NewWeiI(400)}, uint64(100), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+
+		_, fee, _, _, err := cks.NewTxAttemptWithType(ctx, newTx(t, `{"MaxFeePrice": 500}`), lggr, 0x0)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(400), fee.Legacy)
+	})
+
+	t.Run("ignores a max fee price of the policy above the max of the key", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFee", mock.Anything, mock.Anything, uint64(100), assets.NewWeiI(1000)).Return(gas.EvmFee{Legacy: assets.NewWeiI(400)}, uint64(100), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+
+		_, _, _, _, err := cks.NewTxAttemptWithType(ctx, newTx(t, `{"MaxFeePrice": 5000}`), lggr, 0x0)
+		require.NoError(t, err)
+	})
+
+	t.Run("bumps by at least the bump percent of the policy", func(t *testing.T) {
+		previous := txmgr.TxAttempt{TxFee: gas.EvmFee{Legacy: assets.NewWeiI(400)}, TxType: 0x0}
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("BumpFee", mock.Anything, previous.TxFee, uint64(100), assets.NewWeiI(1000), mock.Anything).Return(gas.EvmFee{Legacy: assets.NewWeiI(440)}, uint64(100), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+
+		_, fee, _, _, err := cks.NewBumpTxAttempt(ctx, newTx(t, `{"BumpPercent": 50}`), previous, nil, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(600), fee.Legacy)
+	})
+
+	t.Run("does not bump by the bump percent of the policy above the max fee price", func(t *testing.T) {
+		previous := txmgr.TxAttempt{TxFee: gas.EvmFee{DynamicTipCap: assets.NewWeiI(300), DynamicFeeCap: assets.NewWeiI(400)}, TxType: 0x2}
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("BumpFee", mock.Anything, previous.TxFee, uint64(100), assets.NewWeiI(500), mock.Anything).Return(gas.EvmFee{DynamicTipCap: assets.NewWeiI(330), DynamicFeeCap: assets.NewWeiI(440)}, uint64(100), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+
+		_, fee, _, _, err := cks.NewBumpTxAttempt(ctx, newTx(t, `{"MaxFeePrice": 500, "BumpPercent": 50}`), previous, nil, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(500), fee.DynamicFeeCap)
+		assert.Equal(t, assets.NewWeiI(450), fee.DynamicTipCap)
+	})
+
+	t.Run("invalid fee policy is not retryable", func(t *testing.T) {
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, gasmocks.NewEvmFeeEstimator(t))
+
+		_, _, _, retryable, err := cks.NewTxAttemptWithType(ctx, newTx(t, `{"BumpPercent": -1}`), lggr, 0x0)
+		require.ErrorContains(t, err, "failed to get fee policy")
+		assert.False(t, retryable)
+	})
+}
@@ -0,0 +1,49 @@
+package txmgr
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// multicall3ABI is the aggregate3 method of Multicall3 (https://github.com/mds1/multicall), deployed at the same
+// address on most chains.
+const multicall3ABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+var aggregate3ABI = evmtypes.MustGetABI(multicall3ABI).Methods["aggregate3"]
+
+// call3 is the Multicall3.Call3 argument of aggregate3
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+var _ BatchEncoder = (*multicall3Encoder)(nil)
+
+// multicall3Encoder encodes batches as calls to aggregate3 which don't allow failure, so that a batch either
+// makes all of its calls or reverts. The txs of a reverted batch are then sent on their own, which tells the
+// reverting calls apart.
+type multicall3Encoder struct{}
+
+func NewMulticall3Encoder() *multicall3Encoder {
+	return &multicall3Encoder{}
+}
+
+// EncodeBatch returns the payload calling aggregate3 with the calls of the txs. Its gas limit is the sum of
+// theirs, which covers the overhead of the contract since the batch pays the intrinsic gas only once.
+func (e *multicall3Encoder) EncodeBatch(contract common.Address, txs []*Tx) (payload []byte, gasLimit uint64, err error) {
+	calls := make([]call3, len(txs))
+	for i, tx := range txs {
+		calls[i] = call3{Target: tx.ToAddress, CallData: tx.EncodedPayload}
+		gasLimit += tx.FeeLimit
+	}
+	args, err := aggregate3ABI.Inputs.Pack(calls)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to pack aggregate3 calls: %w", err)
+	}
+	payload = append(append([]byte{}, aggregate3ABI.ID...), args...)
+	return payload, gasLimit, nil
+}
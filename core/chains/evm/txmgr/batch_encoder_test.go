@@ -0,0 +1,52 @@
+package txmgr_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestMulticall3Encoder_EncodeBatch(t *testing.T) {
+	t.Parallel()
+
+	contract := testutils.NewAddress()
+	txs := []*txmgr.Tx{
+		{ToAddress: testutils.NewAddress(), EncodedPayload: []byte{1, 2}, FeeLimit: 100},
+		{ToAddress: testutils.NewAddress(), EncodedPayload: []byte{3}, FeeLimit: 200},
+	}
+
+	payload, gasLimit, err := txmgr.NewMulticall3Encoder().EncodeBatch(contract, txs)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(300), gasLimit)
+	require.Greater(t, len(payload), 4)
+	assert.Equal(t, crypto.Keccak256([]byte("aggregate3((address,bool,bytes)[])"))[:4], payload[:4])
+
+	type call3 struct {
+		Target       common.Address
+		AllowFailure bool
+		CallData     []byte
+	}
+	callType, err := abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{
+		{Name: "target", Type: "address"},
+		{Name: "allowFailure", Type: "bool"},
+		{Name: "callData", Type: "bytes"},
+	})
+	require.NoError(t, err)
+	values, err := abi.Arguments{{Name: "calls", Type: callType}}.Unpack(payload[4:])
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+	calls := *abi.ConvertType(values[0], new([]call3)).(*[]call3)
+	require.Len(t, calls, 2)
+	for i, call := range calls {
+		assert.Equal(t, txs[i].ToAddress, call.Target)
+		assert.False(t, call.AllowFailure)
+		assert.Equal(t, txs[i].EncodedPayload, call.CallData)
+	}
+}
@@ -69,7 +69,7 @@ func NewTestEthBroadcaster(
 		return gas.NewFixedPriceEstimator(config.EVM().GasEstimator(), nil, ge.BlockHistory(), lggr, nil)
 	}, ge.EIP1559DynamicFees(), ge)
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, keyStore, estimator)
-	ethBroadcaster := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(config.EVM().GasEstimator()), config.EVM().Transactions(), gconfig.Database().Listener(), keyStore, txBuilder, nonceTracker, lggr, checkerFactory, nonceAutoSync)
+	ethBroadcaster := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(config.EVM().GasEstimator()), config.EVM().Transactions(), gconfig.Database().Listener(), keyStore, txBuilder, nonceTracker, lggr, checkerFactory, nil, txmgr.NewMulticall3Encoder(), nonceAutoSync)
 
 	// Mark instance as test
 	ethBroadcaster.XXXTestDisableUnstartedTxAutoProcessing()
@@ -99,6 +99,8 @@ func TestEthBroadcaster_Lifecycle(t *testing.T) {
 		txBuilder,
 		logger.Test(t),
 		&testCheckerFactory{},
+		nil,
+		nil,
 		false,
 	)
 
@@ -157,6 +159,8 @@ func TestEthBroadcaster_LoadNextSequenceMapFailure_StartupSuccess(t *testing.T)
 		txBuilder,
 		logger.Test(t),
 		&testCheckerFactory{},
+		nil,
+		nil,
 		false,
 	)
 
@@ -636,6 +640,8 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_OptimisticLockingOnEthTx(t *testi
 		txBuilder,
 		logger.Test(t),
 		&testCheckerFactory{},
+		nil,
+		nil,
 		false,
 	)
 	eb.XXXTestDisableUnstartedTxAutoProcessing()
@@ -707,6 +713,155 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	}
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_KeyPool(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	txStore := cltest.NewTestTxStore(t, db)
+	ctx := testutils.Context(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, busyAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	_, idleAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	cltest.MustInsertUnconfirmedEthTx(t, txStore, 0, busyAddress)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("PendingNonceAt", mock.Anything, mock.Anything).Return(uint64(0), nil)
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, cfg, evmcfg, &testCheckerFactory{}, false, nonceTracker)
+
+	etx := mustCreateUnstartedGeneratedTx(t, txStore, busyAddress, &cltest.FixtureChainID, func(txRequest *txmgr.TxRequest) {
+		txRequest.KeyPool = []gethCommon.Address{busyAddress, idleAddress}
+	})
+	assert.Equal(t, []gethCommon.Address{busyAddress, idleAddress}, etx.KeyPool)
+
+	t.Run("does not assign pooled tx to a key more loaded than another of the pool", func(t *testing.T) {
+		retryable, err := eb.ProcessUnstartedTxs(ctx, busyAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnstarted, etx.State)
+		assert.Len(t, etx.KeyPool, 2)
+	})
+
+	t.Run("assigns pooled tx to the least loaded key of the pool and broadcasts it", func(t *testing.T) {
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, idleAddress).Return(commonclient.Successful, nil).Once()
+
+		retryable, err := eb.ProcessUnstartedTxs(ctx, idleAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+		assert.Equal(t, idleAddress, etx.FromAddress)
+		assert.Empty(t, etx.KeyPool)
+	})
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_KeyPoolFundsCheckFailure(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	txStore := cltest.NewTestTxStore(t, db)
+	ctx := testutils.Context(t)
+	lggr := logger.Test(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, busyAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	_, failingAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	cltest.MustInsertUnconfirmedEthTx(t, txStore, 0, busyAddress)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ge := evmcfg.EVM().GasEstimator()
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("PendingNonceAt", mock.Anything, mock.Anything).Return(uint64(0), nil)
+	estimator := gas.NewEvmFeeEstimator(lggr, func(lggr logger.Logger) gas.EvmEstimator {
+		return gas.NewFixedPriceEstimator(ge, nil, ge.BlockHistory(), lggr, nil)
+	}, ge.EIP1559DynamicFees(), ge)
+	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, estimator)
+	nonceTracker := txmgr.NewNonceTracker(lggr, txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+	fundsChecker := &testKeyFundsChecker{failing: failingAddress, checks: map[gethCommon.Address]int{}}
+	eb := txmgrcommon.NewBroadcaster(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database().Listener(), ethKeyStore, txBuilder, nonceTracker, lggr, &testCheckerFactory{}, fundsChecker, txmgr.NewMulticall3Encoder(), false)
+	eb.XXXTestDisableUnstartedTxAutoProcessing()
+	servicetest.Run(t, eb)
+
+	etx := mustCreateUnstartedGeneratedTx(t, txStore, busyAddress, &cltest.FixtureChainID, func(txRequest *txmgr.TxRequest) {
+		txRequest.KeyPool = []gethCommon.Address{busyAddress, failingAddress}
+	})
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, busyAddress).Return(commonclient.Successful, nil).Once()
+
+	retryable, err := eb.ProcessUnstartedTxs(ctx, busyAddress)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+
+	etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+	assert.Equal(t, busyAddress, etx.FromAddress)
+	assert.Equal(t, 1, fundsChecker.checks[busyAddress])
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Batching(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	txStore := cltest.NewTestTxStore(t, db)
+	ctx := testutils.Context(t)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil)
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, cfg, evmcfg, &testCheckerFactory{}, false, nonceTracker)
+
+	multicall := testutils.NewAddress()
+	strategy := txmgrcommon.NewBatchStrategy(uuid.New(), multicall, time.Hour, 2)
+	etx1 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithStrategy(strategy), txRequestWithValue(big.Int{}))
+
+	t.Run("waits for the window of a batch which is not full", func(t *testing.T) {
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		etx, err := txStore.FindTxWithAttempts(ctx, etx1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnstarted, etx.State)
+		assert.Nil(t, etx.BatchTxID)
+		assert.NotNil(t, etx.BatchPolicy)
+	})
+
+	t.Run("sends a full batch as a single tx to the contract", func(t *testing.T) {
+		etx2 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithStrategy(strategy), txRequestWithValue(big.Int{}))
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && *tx.To() == multicall && tx.Gas() == etx1.FeeLimit+etx2.FeeLimit
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		etx, err := txStore.FindTxWithAttempts(ctx, etx1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnstarted, etx.State)
+		require.NotNil(t, etx.BatchTxID)
+
+		batchTx, err := txStore.FindTxWithAttempts(ctx, *etx.BatchTxID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, batchTx.State)
+		meta, err := batchTx.GetMeta()
+		require.NoError(t, err)
+		assert.Equal(t, []int64{etx1.ID, etx2.ID}, meta.BatchedTxIDs)
+
+		etx, err = txStore.FindTxWithAttempts(ctx, etx2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, batchTx.ID, *etx.BatchTxID)
+	})
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
 	value := big.Int(assets.NewEthValue(142))
@@ -1156,7 +1311,7 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Errors(t *testing.T) {
 					}, evmcfg.EVM().GasEstimator().EIP1559DynamicFees(), evmcfg.EVM().GasEstimator())
 					txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), evmcfg.EVM().GasEstimator(), ethKeyStore, estimator)
 					localNextNonce = getLocalNextNonce(t, nonceTracker, fromAddress)
-					eb2 := txmgr.NewEvmBroadcaster(txStore, txmClient, txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(evmcfg.EVM().GasEstimator()), evmcfg.EVM().Transactions(), cfg.Database().Listener(), ethKeyStore, txBuilder, lggr, &testCheckerFactory{}, false)
+					eb2 := txmgr.NewEvmBroadcaster(txStore, txmClient, txmgr.NewEvmTxmConfig(evmcfg.EVM()), txmgr.NewEvmTxmFeeConfig(evmcfg.EVM().GasEstimator()), evmcfg.EVM().Transactions(), cfg.Database().Listener(), ethKeyStore, txBuilder, lggr, &testCheckerFactory{}, nil, nil, false)
 					retryable, err := eb2.ProcessUnstartedTxs(ctx, fromAddress)
 					assert.NoError(t, err)
 					assert.False(t, retryable)
@@ -1752,7 +1907,7 @@ func TestEthBroadcaster_SyncNonce(t *testing.T) {
 		kst.On("EnabledAddressesForChain", mock.Anything, &cltest.FixtureChainID).Return(addresses, nil).Once()
 		ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
 		txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
-		eb := txmgr.NewEvmBroadcaster(txStore, txmClient, evmTxmCfg, txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database().Listener(), kst, txBuilder, lggr, checkerFactory, false)
+		eb := txmgr.NewEvmBroadcaster(txStore, txmClient, evmTxmCfg, txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database().Listener(), kst, txBuilder, lggr, checkerFactory, nil, nil, false)
 		err := eb.Start(ctx)
 		assert.NoError(t, err)
 
@@ -1801,6 +1956,19 @@ func TestEthBroadcaster_NonceTracker_InProgressTx(t *testing.T) {
 	})
 }
 
+type testKeyFundsChecker struct {
+	failing gethCommon.Address
+	checks  map[gethCommon.Address]int
+}
+
+func (t *testKeyFundsChecker) HasSufficientFunds(_ context.Context, address gethCommon.Address, _ txmgr.Tx) (bool, error) {
+	t.checks[address]++
+	if address == t.failing {
+		return false, errors.New("rpc error")
+	}
+	return true, nil
+}
+
 type testCheckerFactory struct {
 	err error
 }
@@ -49,9 +49,11 @@ func NewTxm(
 	feeCfg := NewEvmTxmFeeConfig(fCfg)                 // wrap Evm specific config
 	txmClient := NewEvmTxmClient(client, clientErrors) // wrap Evm specific client
 	chainID := txmClient.ConfiguredChainID()
-	evmBroadcaster := NewEvmBroadcaster(txStore, txmClient, txmCfg, feeCfg, txConfig, listenerConfig, keyStore, txAttemptBuilder, lggr, checker, chainConfig.NonceAutoSync())
+	keyFundsChecker := NewEvmKeyFundsChecker(client, fCfg, estimator)
+	evmBroadcaster := NewEvmBroadcaster(txStore, txmClient, txmCfg, feeCfg, txConfig, listenerConfig, keyStore, txAttemptBuilder, lggr, checker, keyFundsChecker, NewMulticall3Encoder(), chainConfig.NonceAutoSync())
 	evmTracker := NewEvmTracker(txStore, keyStore, chainID, lggr)
-	evmConfirmer := NewEvmConfirmer(txStore, txmClient, txmCfg, feeCfg, txConfig, dbConfig, keyStore, txAttemptBuilder, lggr)
+	stuckTxDetector := NewEvmStuckTxDetector(lggr, txStore, txConfig.AutoPurge(), chainID)
+	evmConfirmer := NewEvmConfirmer(txStore, txmClient, txmCfg, feeCfg, txConfig, dbConfig, keyStore, txAttemptBuilder, stuckTxDetector, lggr)
 	var evmResender *Resender
 	if txConfig.ResendAfterThreshold() > 0 {
 		evmResender = NewEvmResender(lggr, txStore, txmClient, evmTracker, keyStore, txmgr.DefaultResenderPollInterval, chainConfig, txConfig)
@@ -108,9 +110,20 @@ func NewEvmConfirmer(
 	dbConfig txmgrtypes.ConfirmerDatabaseConfig,
 	keystore KeyStore,
 	txAttemptBuilder TxAttemptBuilder,
+	stuckTxDetector *StuckTxDetector,
 	lggr logger.Logger,
 ) *Confirmer {
-	return txmgr.NewConfirmer(txStore, client, chainConfig, feeConfig, txConfig, dbConfig, keystore, txAttemptBuilder, lggr, func(r *evmtypes.Receipt) bool { return r == nil })
+	return txmgr.NewConfirmer(txStore, client, chainConfig, feeConfig, txConfig, dbConfig, keystore, txAttemptBuilder, stuckTxDetector, lggr, func(r *evmtypes.Receipt) bool { return r == nil })
+}
+
+// NewEvmStuckTxDetector instantiates a new EVM detector of terminally stuck transactions
+func NewEvmStuckTxDetector(
+	lggr logger.Logger,
+	txStore TxStore,
+	cfg txmgrtypes.AutoPurgeConfig,
+	chainID *big.Int,
+) *StuckTxDetector {
+	return txmgr.NewStuckTxDetector(lggr, txStore, cfg, chainID)
 }
 
 // NewEvmTracker instantiates a new EVM tracker for abandoned transactions
@@ -135,8 +148,10 @@ func NewEvmBroadcaster(
 	txAttemptBuilder TxAttemptBuilder,
 	logger logger.Logger,
 	checkerFactory TransmitCheckerFactory,
+	keyFundsChecker KeyFundsChecker,
+	batchEncoder BatchEncoder,
 	autoSyncNonce bool,
 ) *Broadcaster {
 	nonceTracker := NewNonceTracker(logger, txStore, client)
-	return txmgr.NewBroadcaster(txStore, client, chainConfig, feeConfig, txConfig, listenerConfig, keystore, txAttemptBuilder, nonceTracker, logger, checkerFactory, autoSyncNonce)
+	return txmgr.NewBroadcaster(txStore, client, chainConfig, feeConfig, txConfig, listenerConfig, keystore, txAttemptBuilder, nonceTracker, logger, checkerFactory, keyFundsChecker, batchEncoder, autoSyncNonce)
 }
@@ -129,7 +129,7 @@ func TestEthConfirmer_Lifecycle(t *testing.T) {
 	ge := config.EVM().GasEstimator()
 	feeEstimator := gas.NewEvmFeeEstimator(lggr, newEst, ge.EIP1559DynamicFees(), ge)
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, feeEstimator)
-	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ethKeyStore, txBuilder, lggr)
+	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ethKeyStore, txBuilder, txmgr.NewEvmStuckTxDetector(lggr, txStore, config.EVM().Transactions().AutoPurge(), ethClient.ConfiguredChainID()), lggr)
 	ctx := testutils.Context(t)
 
 	// Can't close unstarted instance
@@ -1646,7 +1646,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary_WithConnectivityCheck(t *testing
 		addresses := []gethCommon.Address{fromAddress}
 		kst.On("EnabledAddressesForChain", mock.Anything, &cltest.FixtureChainID).Return(addresses, nil).Maybe()
 		// Create confirmer with necessary state
-		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, lggr)
+		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, txmgr.NewEvmStuckTxDetector(lggr, txStore, ccfg.EVM().Transactions().AutoPurge(), ethClient.ConfiguredChainID()), lggr)
 		servicetest.Run(t, ec)
 		currentHead := int64(30)
 		oldEnough := int64(15)
@@ -1693,7 +1693,7 @@ func TestEthConfirmer_RebroadcastWhereNecessary_WithConnectivityCheck(t *testing
 		txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, kst, feeEstimator)
 		addresses := []gethCommon.Address{fromAddress}
 		kst.On("EnabledAddressesForChain", mock.Anything, &cltest.FixtureChainID).Return(addresses, nil).Maybe()
-		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, lggr)
+		ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), ccfg.EVM(), txmgr.NewEvmTxmFeeConfig(ccfg.EVM().GasEstimator()), ccfg.EVM().Transactions(), cfg.Database(), kst, txBuilder, txmgr.NewEvmStuckTxDetector(lggr, txStore, ccfg.EVM().Transactions().AutoPurge(), ethClient.ConfiguredChainID()), lggr)
 		servicetest.Run(t, ec)
 		currentHead := int64(30)
 		oldEnough := int64(15)
@@ -3119,6 +3119,120 @@ func TestEthConfirmer_ResumePendingRuns(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_ProcessStuckTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].GasEstimator.EIP1559DynamicFees = ptr(false)
+		c.EVM[0].Transactions.AutoPurge.Enabled = ptr(true)
+		c.EVM[0].Transactions.AutoPurge.Threshold = ptr[uint32](10)
+		c.EVM[0].Transactions.AutoPurge.MinAttempts = ptr[uint32](1)
+	})
+	evmcfg := evmtest.NewChainScopedConfig(t, config)
+	ctx := testutils.Context(t)
+
+	pgtest.MustExec(t, db, `SET CONSTRAINTS fk_pipeline_runs_pruning_key DEFERRED`)
+	pgtest.MustExec(t, db, `SET CONSTRAINTS pipeline_runs_pipeline_spec_id_fkey DEFERRED`)
+
+	var resumedErr error
+	ec := newEthConfirmer(t, txStore, ethClient, config, evmcfg, ethKeyStore, func(_ context.Context, _ uuid.UUID, _ interface{}, err error) error {
+		resumedErr = err
+		return nil
+	})
+
+	run := cltest.MustInsertPipelineRun(t, db)
+	tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress)
+	pgtest.MustExec(t, db, `UPDATE evm.txes SET pipeline_task_run_id = $1, signal_callback = TRUE WHERE id = $2`, &tr.ID, etx.ID)
+	pgtest.MustExec(t, db, `UPDATE evm.tx_attempts SET broadcast_before_block_num = 5 WHERE eth_tx_id = $1`, etx.ID)
+
+	t.Run("does not purge tx broadcast fewer than Threshold blocks ago", func(t *testing.T) {
+		require.NoError(t, ec.ProcessStuckTransactions(ctx, 14))
+
+		etx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.TxAttempts, 1)
+		assert.NoError(t, resumedErr)
+	})
+
+	t.Run("purges stuck tx and resumes its pipeline run with an error", func(t *testing.T) {
+		require.NoError(t, ec.ProcessStuckTransactions(ctx, 15))
+
+		etx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+		assert.Equal(t, fromAddress, etx.ToAddress)
+		assert.Empty(t, etx.EncodedPayload)
+		require.Len(t, etx.TxAttempts, 2)
+		assert.Equal(t, txmgrtypes.TxAttemptInProgress, etx.TxAttempts[0].State)
+		assert.True(t, etx.CallbackCompleted)
+
+		meta, err := etx.GetMeta()
+		require.NoError(t, err)
+		require.NotNil(t, meta.Purged)
+		assert.True(t, *meta.Purged)
+		require.NotNil(t, meta.CancelAttemptID)
+		assert.Equal(t, etx.TxAttempts[0].ID, *meta.CancelAttemptID)
+		require.ErrorIs(t, resumedErr, txmgrcommon.ErrTxTerminallyStuck)
+
+		// the purged tx is not purged again
+		require.NoError(t, ec.ProcessStuckTransactions(ctx, 16))
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.TxAttempts, 2)
+	})
+
+	t.Run("marks purged tx fatal once its purge is mined", func(t *testing.T) {
+		etx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		pgtest.MustExec(t, db, `UPDATE evm.tx_attempts SET state = 'broadcast', broadcast_before_block_num = 16 WHERE id = $1`, etx.TxAttempts[0].ID)
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET state = 'confirmed' WHERE id = $1`, etx.ID)
+		mustInsertEthReceipt(t, txStore, 16, utils.NewHash(), etx.TxAttempts[0].Hash)
+
+		require.NoError(t, ec.ProcessStuckTransactions(ctx, 17))
+
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, etx.State)
+		assert.Equal(t, txmgrcommon.ErrTxTerminallyStuck.Error(), etx.Error.String)
+	})
+
+	t.Run("purges stuck tx at the max gas price at that price", func(t *testing.T) {
+		priceMax := evmcfg.EVM().GasEstimator().PriceMax()
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+		pgtest.MustExec(t, db, `UPDATE evm.tx_attempts SET gas_price = $1, broadcast_before_block_num = 5 WHERE eth_tx_id = $2`, priceMax, etx.ID)
+		broadcast := etx.TxAttempts[0]
+
+		require.NoError(t, ec.ProcessStuckTransactions(ctx, 17))
+
+		etx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Empty(t, etx.EncodedPayload)
+		meta, err := etx.GetMeta()
+		require.NoError(t, err)
+		require.NotNil(t, meta.Purged)
+		assert.True(t, *meta.Purged)
+		require.NotNil(t, meta.CancelAttemptID)
+
+		// the broadcast attempt is kept alongside the purge at the same price
+		require.Len(t, etx.TxAttempts, 2)
+		for _, attempt := range etx.TxAttempts {
+			assert.Equal(t, priceMax, attempt.TxFee.Legacy)
+			if attempt.ID == broadcast.ID {
+				assert.Equal(t, txmgrtypes.TxAttemptBroadcast, attempt.State)
+			} else {
+				assert.Equal(t, *meta.CancelAttemptID, attempt.ID)
+				assert.Equal(t, txmgrtypes.TxAttemptInProgress, attempt.State)
+			}
+		}
+	})
+}
+
 func ptr[T any](t T) *T { return &t }
 
 func newEthConfirmer(t testing.TB, txStore txmgr.EvmTxStore, ethClient client.Client, gconfig chainlink.GeneralConfig, config evmconfig.ChainScopedConfig, ks keystore.Eth, fn txmgrcommon.ResumeCallback) *txmgr.Confirmer {
@@ -3128,7 +3242,7 @@ func newEthConfirmer(t testing.TB, txStore txmgr.EvmTxStore, ethClient client.Cl
 		return gas.NewFixedPriceEstimator(ge, nil, ge.BlockHistory(), lggr, nil)
 	}, ge.EIP1559DynamicFees(), ge)
 	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ks, estimator)
-	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ks, txBuilder, lggr)
+	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmConfig(config.EVM()), txmgr.NewEvmTxmFeeConfig(ge), config.EVM().Transactions(), gconfig.Database(), ks, txBuilder, txmgr.NewEvmStuckTxDetector(lggr, txStore, config.EVM().Transactions().AutoPurge(), ethClient.ConfiguredChainID()), lggr)
 	ec.SetResumeCallback(fn)
 	servicetest.Run(t, ec)
 	return ec
@@ -196,6 +196,13 @@ type DbEthTx struct {
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+	Priority          int32
+	// Marshalled TxFeePolicy
+	FeePolicy *sqlutil.JSON
+	KeyPool   pq.ByteaArray
+	// Marshalled TxBatchPolicy
+	BatchPolicy *sqlutil.JSON
+	BatchTxID   *int64
 }
 
 func (db *DbEthTx) FromTx(tx *Tx) {
@@ -218,6 +225,11 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.InitialBroadcastAt = tx.InitialBroadcastAt
 	db.SignalCallback = tx.SignalCallback
 	db.CallbackCompleted = tx.CallbackCompleted
+	db.Priority = tx.Priority
+	db.FeePolicy = tx.FeePolicy
+	db.KeyPool = toByteaArray(tx.KeyPool)
+	db.BatchPolicy = tx.BatchPolicy
+	db.BatchTxID = tx.BatchTxID
 
 	if tx.ChainID != nil {
 		db.EVMChainID = *ubig.New(tx.ChainID)
@@ -253,6 +265,36 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.InitialBroadcastAt = db.InitialBroadcastAt
 	tx.SignalCallback = db.SignalCallback
 	tx.CallbackCompleted = db.CallbackCompleted
+	tx.Priority = db.Priority
+	tx.FeePolicy = db.FeePolicy
+	tx.KeyPool = nil
+	for _, b := range db.KeyPool {
+		tx.KeyPool = append(tx.KeyPool, common.BytesToAddress(b))
+	}
+	tx.BatchPolicy = db.BatchPolicy
+	tx.BatchTxID = db.BatchTxID
+}
+
+// toByteaArray converts the key pool of a tx to its database representation, which is NULL if it's empty
+func toByteaArray(addresses []common.Address) pq.ByteaArray {
+	if len(addresses) == 0 {
+		return nil
+	}
+	a := make(pq.ByteaArray, len(addresses))
+	for i, address := range addresses {
+		a[i] = address.Bytes()
+	}
+	return a
+}
+
+// batchPolicy returns the batch policy of the strategy of the request, or nil if it doesn't batch txs
+func batchPolicy(txRequest TxRequest) *txmgrtypes.TxBatchPolicy[common.Address] {
+	strategy, ok := txRequest.Strategy.(txmgrtypes.BatchingTxStrategy[common.Address])
+	if !ok {
+		return nil
+	}
+	policy := strategy.BatchPolicy()
+	return &policy
 }
 
 func dbEthTxsToEvmEthTxs(dbEthTxs []DbEthTx) []Tx {
@@ -527,8 +569,8 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
+	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed, priority, fee_policy, key_pool, batch_policy, batch_tx_id) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed, :priority, :fee_policy, :key_pool, :batch_policy, :batch_tx_id
 ) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
@@ -669,11 +711,12 @@ func (o *evmTxStore) loadEthTxesAttemptsReceipts(ctx context.Context, etxs []*Tx
 	if len(etxs) == 0 {
 		return nil
 	}
-	attemptHashM := make(map[common.Hash]*TxAttempt, len(etxs)) // len here is lower bound
-	attemptHashes := make([][]byte, len(etxs))                  // len here is lower bound
+	// batched txs share the attempts of their batch tx
+	attemptHashM := make(map[common.Hash][]*TxAttempt, len(etxs)) // len here is lower bound
+	attemptHashes := make([][]byte, len(etxs))                    // len here is lower bound
 	for _, etx := range etxs {
 		for i, attempt := range etx.TxAttempts {
-			attemptHashM[attempt.Hash] = &etx.TxAttempts[i]
+			attemptHashM[attempt.Hash] = append(attemptHashM[attempt.Hash], &etx.TxAttempts[i])
 			attemptHashes = append(attemptHashes, attempt.Hash.Bytes())
 		}
 	}
@@ -685,10 +728,41 @@ func (o *evmTxStore) loadEthTxesAttemptsReceipts(ctx context.Context, etxs []*Tx
 	var receipts []*evmtypes.Receipt = fromDBReceipts(rs)
 
 	for _, receipt := range receipts {
-		attempt := attemptHashM[receipt.TxHash]
-		// Although the attempts struct supports multiple receipts, the expectation for EVM is that there is only one receipt
-		// per tx and therefore attempt too.
-		attempt.Receipts = append(attempt.Receipts, receipt)
+		for _, attempt := range attemptHashM[receipt.TxHash] {
+			// Although the attempts struct supports multiple receipts, the expectation for EVM is that there is only one receipt
+			// per tx and therefore attempt too.
+			attempt.Receipts = append(attempt.Receipts, receipt)
+		}
+	}
+	return nil
+}
+
+// loadBatchedTxesAttempts loads the attempts of the batch txs of the batched txes, which are sent by them
+func (o *evmTxStore) loadBatchedTxesAttempts(ctx context.Context, etxs []*Tx) error {
+	var batchTxIDs []int64
+	batchedTxesM := make(map[int64][]*Tx)
+	for _, etx := range etxs {
+		if etx.BatchTxID == nil {
+			continue
+		}
+		if _, exists := batchedTxesM[*etx.BatchTxID]; !exists {
+			batchTxIDs = append(batchTxIDs, *etx.BatchTxID)
+		}
+		batchedTxesM[*etx.BatchTxID] = append(batchedTxesM[*etx.BatchTxID], etx)
+	}
+	if len(batchTxIDs) == 0 {
+		return nil
+	}
+	var dbTxAttempts []DbEthTxAttempt
+	if err := o.q.SelectContext(ctx, &dbTxAttempts, `SELECT * FROM evm.tx_attempts WHERE eth_tx_id = ANY($1) ORDER BY evm.tx_attempts.gas_price DESC, evm.tx_attempts.gas_tip_cap DESC`, pq.Array(batchTxIDs)); err != nil {
+		return pkgerrors.Wrap(err, "loadBatchedTxesAttempts failed to load evm.tx_attempts")
+	}
+	for _, dbAttempt := range dbTxAttempts {
+		var attempt TxAttempt
+		dbAttempt.ToTxAttempt(&attempt)
+		for _, etx := range batchedTxesM[dbAttempt.EthTxID] {
+			etx.TxAttempts = append(etx.TxAttempts, attempt)
+		}
 	}
 	return nil
 }
@@ -1242,6 +1316,26 @@ func (o *evmTxStore) SaveConfirmedMissingReceiptAttempt(ctx context.Context, tim
 	return pkgerrors.Wrap(err, "SaveConfirmedMissingReceiptAttempt failed")
 }
 
+// DeleteUnstartedTx deletes the tx with the given ID, if it is still unstarted. It returns sql.ErrNoRows
+// if there is no such tx.
+func (o *evmTxStore) DeleteUnstartedTx(ctx context.Context, id int64, chainID *big.Int) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	res, err := o.q.ExecContext(ctx, `DELETE FROM evm.txes WHERE id = $1 AND state = 'unstarted' AND evm_chain_id = $2`, id, chainID.String())
+	if err != nil {
+		return pkgerrors.Wrap(err, "DeleteUnstartedTx failed")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return pkgerrors.Wrap(err, "DeleteUnstartedTx failed to get RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return pkgerrors.Wrapf(sql.ErrNoRows, "DeleteUnstartedTx found no unstarted evm.tx with id %d", id)
+	}
+	return nil
+}
+
 func (o *evmTxStore) DeleteInProgressAttempt(ctx context.Context, attempt TxAttempt) error {
 	var cancel context.CancelFunc
 	ctx, cancel = o.mergeContexts(ctx)
@@ -1256,6 +1350,68 @@ func (o *evmTxStore) DeleteInProgressAttempt(ctx context.Context, attempt TxAtte
 	return pkgerrors.Wrap(err, "DeleteInProgressAttempt failed")
 }
 
+// SaveCancellationAttempt replaces the destination, value, payload and meta of the unconfirmed tx with those of
+// its cancellation, and inserts the in_progress attempt sending it. The ID of the attempt is recorded in the
+// CancelAttemptID of the tx meta. Attempts which were never broadcast are deleted since the cancellation replaces
+// them, but broadcast attempts are kept since any of them could still be mined.
+func (o *evmTxStore) SaveCancellationAttempt(ctx context.Context, etx *Tx, attempt *TxAttempt) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	if etx.State != txmgr.TxUnconfirmed {
+		return pkgerrors.Errorf("SaveCancellationAttempt failed: can only cancel unconfirmed transactions, transaction is currently %s", etx.State)
+	}
+	if attempt.State != txmgrtypes.TxAttemptInProgress {
+		return errors.New("SaveCancellationAttempt failed: attempt state must be in_progress")
+	}
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		var dbAttempt DbEthTxAttempt
+		dbAttempt.FromTxAttempt(attempt)
+		if _, err := orm.q.ExecContext(ctx, `DELETE FROM evm.tx_attempts WHERE eth_tx_id = $1 AND state <> 'broadcast'`, etx.ID); err != nil {
+			return pkgerrors.Wrap(err, "SaveCancellationAttempt failed to delete replaced evm.tx_attempts")
+		}
+		query, args, err := orm.q.BindNamed(insertIntoEthTxAttemptsQuery, &dbAttempt)
+		if err != nil {
+			return pkgerrors.Wrap(err, "SaveCancellationAttempt failed to BindNamed")
+		}
+		if err = orm.q.GetContext(ctx, &dbAttempt, query, args...); err != nil {
+			return pkgerrors.Wrap(err, "SaveCancellationAttempt failed to insert into evm.tx_attempts")
+		}
+		dbAttempt.ToTxAttempt(attempt)
+
+		var dbEtx DbEthTx
+		dbEtx.FromTx(etx)
+		err = orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes
+SET to_address = $1, value = $2, encoded_payload = $3, meta = COALESCE($4::jsonb, '{}'::jsonb) || jsonb_build_object('CancelAttemptID', $5::bigint)
+WHERE id = $6 AND state = 'unconfirmed'
+RETURNING *`, dbEtx.ToAddress, dbEtx.Value, dbEtx.EncodedPayload, dbEtx.Meta, attempt.ID, etx.ID)
+		if err != nil {
+			return pkgerrors.Wrap(err, "SaveCancellationAttempt failed to update evm.txes")
+		}
+		dbEtx.ToTx(etx)
+		attempt.Tx = *etx
+		return nil
+	})
+}
+
+// MarkPurgedTxsFatal marks the confirmed purged txs whose purge was mined as fatally errored, and returns
+// their IDs. They keep their nonce, since it was consumed by the purge.
+func (o *evmTxStore) MarkPurgedTxsFatal(ctx context.Context, chainID *big.Int) (ids []int64, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	err = o.q.SelectContext(ctx, &ids, `
+UPDATE evm.txes SET state = 'fatal_error', error = $1
+WHERE evm.txes.evm_chain_id = $2 AND evm.txes.state = 'confirmed' AND (evm.txes.meta->>'Purged')::boolean
+	AND EXISTS (
+		SELECT 1 FROM evm.receipts
+		INNER JOIN evm.tx_attempts ON evm.tx_attempts.hash = evm.receipts.tx_hash
+		WHERE evm.tx_attempts.eth_tx_id = evm.txes.id AND evm.tx_attempts.id >= (evm.txes.meta->>'CancelAttemptID')::bigint
+	)
+RETURNING evm.txes.id`, txmgr.ErrTxTerminallyStuck.Error(), chainID.String())
+	return ids, pkgerrors.Wrap(err, "MarkPurgedTxsFatal failed")
+}
+
 // SaveInProgressAttempt inserts or updates an attempt
 func (o *evmTxStore) SaveInProgressAttempt(ctx context.Context, attempt *TxAttempt) error {
 	var cancel context.CancelFunc
@@ -1374,6 +1530,27 @@ ORDER BY nonce ASC
 	return
 }
 
+// FindOldestUnconfirmedTx returns the unconfirmed tx with the lowest nonce of the address, with its attempts,
+// or nil if it has none
+func (o *evmTxStore) FindOldestUnconfirmedTx(ctx context.Context, fromAddress common.Address, chainID *big.Int) (etx *Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	err = o.Transact(ctx, true, func(orm *evmTxStore) error {
+		var dbEtx DbEthTx
+		err = orm.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND evm_chain_id = $2 AND state = 'unconfirmed' ORDER BY nonce ASC LIMIT 1`, fromAddress, chainID.String())
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return pkgerrors.Wrap(err, "FindOldestUnconfirmedTx failed to load evm.txes")
+		}
+		etx = new(Tx)
+		dbEtx.ToTx(etx)
+		return pkgerrors.Wrap(orm.loadTxAttemptsAtomic(ctx, etx), "FindOldestUnconfirmedTx failed to load evm.tx_attempts")
+	})
+	return
+}
+
 // FindTxsRequiringResubmissionDueToInsufficientFunds returns transactions
 // that need to be re-sent because they hit an out-of-eth error on a previous
 // block
@@ -1526,13 +1703,15 @@ func (o *evmTxStore) SaveReplacementInProgressAttempt(ctx context.Context, oldAt
 	})
 }
 
-// Finds earliest saved transaction that has yet to be broadcast from the given address
+// Finds the highest priority, earliest saved transaction that has yet to be broadcast from the given address.
+// Pooled transactions are skipped until they are assigned to the address, and batched transactions are left
+// for their batch to send.
 func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*Tx, error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.mergeContexts(ctx)
 	defer cancel()
 	var dbEtx DbEthTx
-	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 AND key_pool IS NULL AND batch_policy IS NULL ORDER BY priority DESC, value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
 	etx := new(Tx)
 	dbEtx.ToTx(etx)
 	if err != nil {
@@ -1542,6 +1721,139 @@ func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context
 	return etx, nil
 }
 
+func (o *evmTxStore) FindNextUnstartedPooledTx(ctx context.Context, fromAddress common.Address, chainID *big.Int) (etx *Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	var dbEtx DbEthTx
+	err = o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE key_pool @> ARRAY[$1::bytea] AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY priority DESC, value ASC, created_at ASC, id ASC LIMIT 1`, fromAddress.Bytes(), chainID.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to FindNextUnstartedPooledTx")
+	}
+	etx = new(Tx)
+	dbEtx.ToTx(etx)
+	return etx, nil
+}
+
+func (o *evmTxStore) AssignPooledTx(ctx context.Context, id int64, fromAddress common.Address, chainID *big.Int) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	res, err := o.q.ExecContext(ctx, `UPDATE evm.txes SET from_address = $1, key_pool = NULL WHERE id = $2 AND state = 'unstarted' AND key_pool IS NOT NULL AND evm_chain_id = $3`, fromAddress, id, chainID.String())
+	if err != nil {
+		return pkgerrors.Wrap(err, "AssignPooledTx failed")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return pkgerrors.Wrap(err, "AssignPooledTx failed to get RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// FindUnstartedBatchableTxs returns the unstarted txs of the address which are waiting to be batched, oldest first
+func (o *evmTxStore) FindUnstartedBatchableTxs(ctx context.Context, fromAddress common.Address, chainID *big.Int) (etxs []*Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	var dbEtxs []DbEthTx
+	err = o.q.SelectContext(ctx, &dbEtxs, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 AND batch_policy IS NOT NULL AND batch_tx_id IS NULL ORDER BY created_at ASC, id ASC`, fromAddress, chainID.String())
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to FindUnstartedBatchableTxs")
+	}
+	etxs = make([]*Tx, len(dbEtxs))
+	dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
+	return etxs, nil
+}
+
+// CreateBatchTx creates the batch tx making the calls of the unstarted batchable txs with the given IDs, and
+// links them to it. It returns sql.ErrNoRows if any of them is no longer waiting to be batched.
+func (o *evmTxStore) CreateBatchTx(ctx context.Context, txRequest TxRequest, ids []int64, chainID *big.Int) (tx Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
+		var terr error
+		if tx, terr = orm.CreateTransaction(ctx, txRequest, chainID); terr != nil {
+			return pkgerrors.Wrap(terr, "CreateBatchTx failed to insert batch tx")
+		}
+		res, terr := orm.q.ExecContext(ctx, `UPDATE evm.txes SET batch_tx_id = $1 WHERE id = ANY($2) AND state = 'unstarted' AND batch_policy IS NOT NULL AND batch_tx_id IS NULL AND evm_chain_id = $3`, tx.ID, pq.Array(ids), chainID.String())
+		if terr != nil {
+			return pkgerrors.Wrap(terr, "CreateBatchTx failed to update batched txs")
+		}
+		rowsAffected, terr := res.RowsAffected()
+		if terr != nil {
+			return pkgerrors.Wrap(terr, "CreateBatchTx failed to get RowsAffected")
+		}
+		if rowsAffected != int64(len(ids)) {
+			return sql.ErrNoRows
+		}
+		return nil
+	})
+	return tx, err
+}
+
+// UnbatchTxs marks the unstarted batchable txs with the given IDs to be sent on their own
+func (o *evmTxStore) UnbatchTxs(ctx context.Context, ids []int64, chainID *big.Int) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	_, err := o.q.ExecContext(ctx, `UPDATE evm.txes SET batch_policy = NULL WHERE id = ANY($1) AND state = 'unstarted' AND batch_tx_id IS NULL AND evm_chain_id = $2`, pq.Array(ids), chainID.String())
+	return pkgerrors.Wrap(err, "UnbatchTxs failed")
+}
+
+// UpdateBatchedTxs brings the batched txs in line with their batch tx, and returns the IDs of the txs it
+// confirmed and of those it unbatched:
+//   - txs whose batch was successfully mined are confirmed, without a nonce of their own
+//   - txs whose batch was re-orged out go back to waiting for it
+//   - txs whose batch reverted, errored or was cancelled by a purge are unbatched, to be sent on their own
+func (o *evmTxStore) UpdateBatchedTxs(ctx context.Context, chainID *big.Int) (confirmed []int64, unbatched []int64, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
+		if _, err := orm.q.ExecContext(ctx, `
+UPDATE evm.txes AS batched SET state = 'unstarted', broadcast_at = NULL, initial_broadcast_at = NULL
+FROM evm.txes AS batch
+WHERE batched.batch_tx_id = batch.id AND batched.state = 'confirmed' AND batched.evm_chain_id = $1
+	AND batch.state IN ('in_progress', 'unconfirmed', 'confirmed_missing_receipt')`, chainID.String()); err != nil {
+			return pkgerrors.Wrap(err, "failed to update re-orged batched evm.txes")
+		}
+		if err := orm.q.SelectContext(ctx, &unbatched, `
+UPDATE evm.txes AS batched SET state = 'unstarted', broadcast_at = NULL, initial_broadcast_at = NULL, batch_policy = NULL, batch_tx_id = NULL
+FROM evm.txes AS batch
+WHERE batched.batch_tx_id = batch.id AND batched.state IN ('unstarted', 'confirmed') AND batched.evm_chain_id = $1
+	AND (batch.state = 'fatal_error' OR batch.state = 'confirmed' AND EXISTS (
+		SELECT 1 FROM evm.receipts
+		INNER JOIN evm.tx_attempts ON evm.tx_attempts.hash = evm.receipts.tx_hash
+		WHERE evm.tx_attempts.eth_tx_id = batch.id
+			AND (evm.receipts.receipt->>'status' = '0x0' OR evm.tx_attempts.id >= (batch.meta->>'CancelAttemptID')::bigint)
+	))
+RETURNING batched.id`, chainID.String()); err != nil {
+			return pkgerrors.Wrap(err, "failed to unbatch evm.txes")
+		}
+		if err := orm.q.SelectContext(ctx, &confirmed, `
+UPDATE evm.txes AS batched SET state = 'confirmed', broadcast_at = batch.broadcast_at, initial_broadcast_at = batch.initial_broadcast_at
+FROM evm.txes AS batch
+WHERE batched.batch_tx_id = batch.id AND batched.state = 'unstarted' AND batched.evm_chain_id = $1
+	AND batch.state = 'confirmed' AND EXISTS (
+		SELECT 1 FROM evm.receipts
+		INNER JOIN evm.tx_attempts ON evm.tx_attempts.hash = evm.receipts.tx_hash
+		WHERE evm.tx_attempts.eth_tx_id = batch.id AND evm.receipts.receipt->>'status' = '0x1'
+			AND (batch.meta->>'CancelAttemptID' IS NULL OR evm.tx_attempts.id < (batch.meta->>'CancelAttemptID')::bigint)
+	)
+RETURNING batched.id`, chainID.String()); err != nil {
+			return pkgerrors.Wrap(err, "failed to confirm batched evm.txes")
+		}
+		return nil
+	})
+	return confirmed, unbatched, pkgerrors.Wrap(err, "UpdateBatchedTxs failed")
+}
+
 func (o *evmTxStore) UpdateTxFatalError(ctx context.Context, etx *Tx) error {
 	var cancel context.CancelFunc
 	ctx, cancel = o.mergeContexts(ctx)
@@ -1722,7 +2034,7 @@ func (o *evmTxStore) countTransactionsWithState(ctx context.Context, fromAddress
 	var cancel context.CancelFunc
 	ctx, cancel = o.mergeContexts(ctx)
 	defer cancel()
-	err = o.q.GetContext(ctx, &count, `SELECT count(*) FROM evm.txes WHERE from_address = $1 AND state = $2 AND evm_chain_id = $3`,
+	err = o.q.GetContext(ctx, &count, `SELECT count(*) FROM evm.txes WHERE from_address = $1 AND state = $2 AND evm_chain_id = $3 AND key_pool IS NULL`,
 		fromAddress, state, chainID.String())
 	return count, pkgerrors.Wrap(err, "failed to countTransactionsWithState")
 }
@@ -1758,7 +2070,7 @@ func (o *evmTxStore) CheckTxQueueCapacity(ctx context.Context, fromAddress commo
 		return nil
 	}
 	var count uint64
-	err = o.q.GetContext(ctx, &count, `SELECT count(*) FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2`, fromAddress, chainID.String())
+	err = o.q.GetContext(ctx, &count, `SELECT count(*) FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 AND key_pool IS NULL`, fromAddress, chainID.String())
 	if err != nil {
 		err = pkgerrors.Wrap(err, "CheckTxQueueCapacity query failed")
 		return
@@ -1770,6 +2082,26 @@ func (o *evmTxStore) CheckTxQueueCapacity(ctx context.Context, fromAddress commo
 	return
 }
 
+func (o *evmTxStore) CheckPooledTxQueueCapacity(ctx context.Context, keyPool []common.Address, maxQueuedTransactions uint64, chainID *big.Int) (err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.mergeContexts(ctx)
+	defer cancel()
+	if maxQueuedTransactions == 0 {
+		return nil
+	}
+	var count uint64
+	err = o.q.GetContext(ctx, &count, `SELECT count(*) FROM evm.txes WHERE key_pool && $1 AND state = 'unstarted' AND evm_chain_id = $2`, toByteaArray(keyPool), chainID.String())
+	if err != nil {
+		err = pkgerrors.Wrap(err, "CheckPooledTxQueueCapacity query failed")
+		return
+	}
+
+	if count >= maxQueuedTransactions {
+		err = pkgerrors.Errorf("cannot create transaction; too many unstarted transactions in the queue of the key pool (%v/%v). %s", count, maxQueuedTransactions, label.MaxQueuedTransactionsWarning)
+	}
+	return
+}
+
 func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest, chainID *big.Int) (tx Tx, err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.mergeContexts(ctx)
@@ -1788,12 +2120,12 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 			}
 		}
 		err = orm.q.GetContext(ctx, &dbEtx, `
-INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback, priority, fee_policy, key_pool, batch_policy)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17
 )
 RETURNING "txes".*
-`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback, txRequest.Priority, txRequest.FeePolicy, toByteaArray(txRequest.KeyPool), batchPolicy(txRequest))
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
 		}
@@ -1938,7 +2270,8 @@ func (o *evmTxStore) FindTxesWithMetaFieldByReceiptBlockNum(ctx context.Context,
 	return txes, pkgerrors.Wrap(err, "failed to FindTxesWithMetaFieldByReceiptBlockNum")
 }
 
-// Find transactions loaded with transaction attempts and receipts by transaction IDs and states
+// Find transactions loaded with transaction attempts and receipts by transaction IDs and states. Batched
+// transactions are loaded with the attempts and receipts of their batch transaction.
 func (o *evmTxStore) FindTxesWithAttemptsAndReceiptsByIdsAndState(ctx context.Context, ids []int64, states []txmgrtypes.TxState, chainID *big.Int) (txes []*Tx, err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.mergeContexts(ctx)
@@ -1953,6 +2286,9 @@ func (o *evmTxStore) FindTxesWithAttemptsAndReceiptsByIdsAndState(ctx context.Co
 		if err = orm.LoadTxesAttempts(ctx, txes); err != nil {
 			return pkgerrors.Wrapf(err, "failed to load evm.tx_attempts for evm.tx")
 		}
+		if err = orm.loadBatchedTxesAttempts(ctx, txes); err != nil {
+			return pkgerrors.Wrapf(err, "failed to load evm.tx_attempts for batched evm.tx")
+		}
 		if err = orm.loadEthTxesAttemptsReceipts(ctx, txes); err != nil {
 			return pkgerrors.Wrapf(err, "failed to load evm.receipts for evm.tx")
 		}
@@ -990,6 +990,177 @@ func TestORM_DeleteInProgressAttempt(t *testing.T) {
 	})
 }
 
+func TestORM_DeleteUnstartedTx(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	t.Run("deletes unstarted tx", func(t *testing.T) {
+		etx := mustCreateUnstartedTx(t, txStore, fromAddress, testutils.NewAddress(), []byte{1, 2, 3}, 21000, big.Int{}, testutils.FixtureChainID)
+
+		require.NoError(t, txStore.DeleteUnstartedTx(ctx, etx.ID, testutils.FixtureChainID))
+
+		_, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("does not delete started tx", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+
+		err := txStore.DeleteUnstartedTx(ctx, etx.ID, testutils.FixtureChainID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		_, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("does not delete tx on another chain", func(t *testing.T) {
+		etx := mustCreateUnstartedTx(t, txStore, fromAddress, testutils.NewAddress(), []byte{1, 2, 3}, 21000, big.Int{}, testutils.FixtureChainID)
+
+		err := txStore.DeleteUnstartedTx(ctx, etx.ID, testutils.SimulatedChainID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
+func TestORM_SaveCancellationAttempt(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	t.Run("replaces the tx payload and saves the attempt", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+		cancelled := etx
+		cancelled.ToAddress = fromAddress
+		cancelled.Value = big.Int{}
+		cancelled.EncodedPayload = []byte{}
+		attempt := cltest.NewDynamicFeeEthTxAttempt(t, etx.ID)
+
+		require.NoError(t, txStore.SaveCancellationAttempt(ctx, &cancelled, &attempt))
+		require.NotZero(t, attempt.ID)
+
+		etx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+		assert.Equal(t, fromAddress, etx.ToAddress)
+		assert.Equal(t, int64(0), etx.Value.Int64())
+		assert.Empty(t, etx.EncodedPayload)
+		require.Len(t, etx.TxAttempts, 2)
+
+		meta, err := etx.GetMeta()
+		require.NoError(t, err)
+		require.NotNil(t, meta.CancelAttemptID)
+		assert.Equal(t, attempt.ID, *meta.CancelAttemptID)
+	})
+
+	t.Run("keeps broadcast attempts and deletes the others", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, fromAddress)
+		broadcast := etx.TxAttempts[0]
+		inProgress := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+		inProgress.TxFee = gas.EvmFee{Legacy: assets.NewWeiI(2)}
+		require.NoError(t, txStore.InsertTxAttempt(ctx, &inProgress))
+		cancelled := etx
+		cancelled.ToAddress = fromAddress
+		cancelled.Value = big.Int{}
+		cancelled.EncodedPayload = []byte{}
+		attempt := cltest.NewDynamicFeeEthTxAttempt(t, etx.ID)
+
+		require.NoError(t, txStore.SaveCancellationAttempt(ctx, &cancelled, &attempt))
+
+		etx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.TxAttempts, 2)
+		assert.Equal(t, attempt.ID, etx.TxAttempts[0].ID)
+		assert.Equal(t, broadcast.ID, etx.TxAttempts[1].ID)
+		assert.Equal(t, txmgrtypes.TxAttemptBroadcast, etx.TxAttempts[1].State)
+	})
+
+	t.Run("does not cancel confirmed tx", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 2, 1, fromAddress)
+		attempt := cltest.NewDynamicFeeEthTxAttempt(t, etx.ID)
+
+		require.Error(t, txStore.SaveCancellationAttempt(ctx, &etx, &attempt))
+	})
+}
+
+func TestORM_FindOldestUnconfirmedTx(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	etx, err := txStore.FindOldestUnconfirmedTx(ctx, fromAddress, testutils.FixtureChainID)
+	require.NoError(t, err)
+	assert.Nil(t, etx)
+
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, fromAddress)
+	oldest := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, fromAddress)
+
+	etx, err = txStore.FindOldestUnconfirmedTx(ctx, fromAddress, testutils.FixtureChainID)
+	require.NoError(t, err)
+	require.NotNil(t, etx)
+	assert.Equal(t, oldest.ID, etx.ID)
+	assert.Len(t, etx.TxAttempts, 1)
+}
+
+func TestORM_MarkPurgedTxsFatal(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	purge := func(t *testing.T, nonce int64) (txmgr.Tx, txmgr.TxAttempt) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, nonce, fromAddress)
+		purged := etx
+		purged.ToAddress = fromAddress
+		purged.Value = big.Int{}
+		purged.EncodedPayload = []byte{}
+		meta := sqlutil.JSON(`{"Purged": true}`)
+		purged.Meta = &meta
+		attempt := cltest.NewDynamicFeeEthTxAttempt(t, etx.ID)
+		require.NoError(t, txStore.SaveCancellationAttempt(ctx, &purged, &attempt))
+		pgtest.MustExec(t, db, `UPDATE evm.tx_attempts SET state = 'broadcast', broadcast_before_block_num = 1 WHERE eth_tx_id = $1`, etx.ID)
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET state = 'confirmed' WHERE id = $1`, etx.ID)
+		return etx, attempt
+	}
+
+	// the purge was mined
+	purgedTx, purgeAttempt := purge(t, 1)
+	mustInsertEthReceipt(t, txStore, 1, utils.NewHash(), purgeAttempt.Hash)
+	// the original tx was mined before its purge
+	minedTx, _ := purge(t, 2)
+	mustInsertEthReceipt(t, txStore, 1, utils.NewHash(), minedTx.TxAttempts[0].Hash)
+
+	ids, err := txStore.MarkPurgedTxsFatal(ctx, testutils.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{purgedTx.ID}, ids)
+
+	etx, err := txStore.FindTxWithAttempts(ctx, purgedTx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxFatalError, etx.State)
+	assert.Equal(t, txmgrcommon.ErrTxTerminallyStuck.Error(), etx.Error.String)
+	require.NotNil(t, etx.Sequence)
+	assert.Equal(t, evmtypes.Nonce(1), *etx.Sequence)
+
+	etx, err = txStore.FindTxWithAttempts(ctx, minedTx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxConfirmed, etx.State)
+}
+
 func TestORM_SaveInProgressAttempt(t *testing.T) {
 	t.Parallel()
 
@@ -1267,6 +1438,198 @@ func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, resultEtx)
 	})
+
+	t.Run("finds higher priority unstarted tx first", func(t *testing.T) {
+		etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, func(txRequest *txmgr.TxRequest) {
+			txRequest.Priority = txmgrtypes.TxPriorityHigh
+			txRequest.FeePolicy = &txmgrtypes.TxFeePolicy{MaxFeePrice: big.NewInt(100)}
+		})
+		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(testutils.Context(t), fromAddress, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Equal(t, etx.ID, resultEtx.ID)
+		assert.Equal(t, txmgrtypes.TxPriorityHigh, resultEtx.Priority)
+		feePolicy, err := resultEtx.GetFeePolicy()
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(100), feePolicy.MaxFeePrice)
+	})
+}
+
+func TestORM_AssignPooledTx(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	_, otherAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, func(txRequest *txmgr.TxRequest) {
+		txRequest.KeyPool = []common.Address{fromAddress, otherAddress}
+	})
+
+	t.Run("pooled tx is not in the unstarted queue of its address until assigned", func(t *testing.T) {
+		_, err := txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, &cltest.FixtureChainID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		count, err := txStore.CountUnstartedTransactions(ctx, fromAddress, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("finds pooled tx for each key of the pool", func(t *testing.T) {
+		for _, address := range []common.Address{fromAddress, otherAddress} {
+			pooledTx, err := txStore.FindNextUnstartedPooledTx(ctx, address, &cltest.FixtureChainID)
+			require.NoError(t, err)
+			require.NotNil(t, pooledTx)
+			assert.Equal(t, etx.ID, pooledTx.ID)
+		}
+		pooledTx, err := txStore.FindNextUnstartedPooledTx(ctx, testutils.NewAddress(), &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Nil(t, pooledTx)
+	})
+
+	t.Run("assigns pooled tx only once", func(t *testing.T) {
+		require.NoError(t, txStore.AssignPooledTx(ctx, etx.ID, otherAddress, &cltest.FixtureChainID))
+		require.ErrorIs(t, txStore.AssignPooledTx(ctx, etx.ID, fromAddress, &cltest.FixtureChainID), sql.ErrNoRows)
+
+		assignedTx, err := txStore.FindNextUnstartedTransactionFromAddress(ctx, otherAddress, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, etx.ID, assignedTx.ID)
+		assert.Empty(t, assignedTx.KeyPool)
+		pooledTx, err := txStore.FindNextUnstartedPooledTx(ctx, fromAddress, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Nil(t, pooledTx)
+	})
+}
+
+func TestORM_UpdateBatchedTxs(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	multicall := testutils.NewAddress()
+	strategy := txmgrcommon.NewBatchStrategy(uuid.New(), multicall, time.Minute, 10)
+	etx1 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithStrategy(strategy), txRequestWithValue(big.Int{}))
+	etx2 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithStrategy(strategy), txRequestWithValue(big.Int{}))
+	ids := []int64{etx1.ID, etx2.ID}
+
+	assertBatchedStates := func(t *testing.T, state txmgrtypes.TxState) {
+		for _, id := range ids {
+			etx, err := txStore.FindTxWithAttempts(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, state, etx.State)
+		}
+	}
+
+	t.Run("batchable txs are not in the unstarted queue of their address", func(t *testing.T) {
+		_, err := txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, &cltest.FixtureChainID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		etxs, err := txStore.FindUnstartedBatchableTxs(ctx, fromAddress, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, etxs, 2)
+		assert.Equal(t, etx1.ID, etxs[0].ID)
+		assert.Equal(t, etx2.ID, etxs[1].ID)
+	})
+
+	batchRequest := txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      multicall,
+		EncodedPayload: []byte{1, 2, 3},
+		FeeLimit:       etx1.FeeLimit + etx2.FeeLimit,
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	}
+	batchTx, err := txStore.CreateBatchTx(ctx, batchRequest, ids, &cltest.FixtureChainID)
+	require.NoError(t, err)
+
+	t.Run("batches txs only once", func(t *testing.T) {
+		_, err := txStore.CreateBatchTx(ctx, batchRequest, ids, &cltest.FixtureChainID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		etxs, err := txStore.FindUnstartedBatchableTxs(ctx, fromAddress, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Empty(t, etxs)
+		etx, err := txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, batchTx.ID, etx.ID)
+	})
+
+	pgtest.MustExec(t, db, `UPDATE evm.txes SET state = 'confirmed', nonce = 0, broadcast_at = NOW(), initial_broadcast_at = NOW() WHERE id = $1`, batchTx.ID)
+	attempt := cltest.NewLegacyEthTxAttempt(t, batchTx.ID)
+	attempt.State = txmgrtypes.TxAttemptBroadcast
+	require.NoError(t, txStore.InsertTxAttempt(ctx, &attempt))
+	receipt := mustInsertEthReceipt(t, txStore, 1, utils.NewHash(), attempt.Hash)
+
+	t.Run("confirms txs of a mined batch with its receipt", func(t *testing.T) {
+		confirmed, unbatched, err := txStore.UpdateBatchedTxs(ctx, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, ids, confirmed)
+		assert.Empty(t, unbatched)
+
+		etxs, err := txStore.FindTxesWithAttemptsAndReceiptsByIdsAndState(ctx, ids, []txmgrtypes.TxState{txmgrcommon.TxConfirmed}, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, etxs, 2)
+		for _, etx := range etxs {
+			assert.Nil(t, etx.Sequence)
+			require.Len(t, etx.TxAttempts, 1)
+			require.Len(t, etx.TxAttempts[0].Receipts, 1)
+			assert.Equal(t, receipt.TxHash, etx.TxAttempts[0].Receipts[0].GetTxHash())
+		}
+	})
+
+	t.Run("puts txs of a re-orged batch back to unstarted", func(t *testing.T) {
+		pgtest.MustExec(t, db, `DELETE FROM evm.receipts WHERE id = $1`, receipt.ID)
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET state = 'unconfirmed' WHERE id = $1`, batchTx.ID)
+
+		confirmed, unbatched, err := txStore.UpdateBatchedTxs(ctx, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Empty(t, confirmed)
+		assert.Empty(t, unbatched)
+		assertBatchedStates(t, txmgrcommon.TxUnstarted)
+	})
+
+	t.Run("unbatches txs of a reverted batch", func(t *testing.T) {
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET state = 'confirmed' WHERE id = $1`, batchTx.ID)
+		mustInsertRevertedEthReceipt(t, txStore, 2, utils.NewHash(), attempt.Hash)
+
+		confirmed, unbatched, err := txStore.UpdateBatchedTxs(ctx, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Empty(t, confirmed)
+		assert.ElementsMatch(t, ids, unbatched)
+		assertBatchedStates(t, txmgrcommon.TxUnstarted)
+
+		etx, err := txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, etx1.ID, etx.ID)
+		assert.Nil(t, etx.BatchTxID)
+		assert.Nil(t, etx.BatchPolicy)
+	})
+}
+
+func TestORM_UnbatchTxs(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	strategy := txmgrcommon.NewBatchStrategy(uuid.New(), testutils.NewAddress(), time.Minute, 10)
+	etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithStrategy(strategy), txRequestWithValue(big.Int{}))
+
+	require.NoError(t, txStore.UnbatchTxs(ctx, []int64{etx.ID}, &cltest.FixtureChainID))
+
+	etxs, err := txStore.FindUnstartedBatchableTxs(ctx, fromAddress, &cltest.FixtureChainID)
+	require.NoError(t, err)
+	assert.Empty(t, etxs)
+	unbatchedTx, err := txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, &cltest.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, etx.ID, unbatchedTx.ID)
 }
 
 func TestORM_UpdateTxFatalError(t *testing.T) {
@@ -1715,6 +2078,57 @@ func TestORM_CheckTxQueueCapacity(t *testing.T) {
 	})
 }
 
+func TestORM_CheckPooledTxQueueCapacity(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	keyPool := []common.Address{fromAddress, otherAddress}
+	var maxQueuedTransactions uint64 = 2
+
+	for i := 0; i < int(maxQueuedTransactions); i++ {
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, func(txRequest *txmgr.TxRequest) {
+			txRequest.KeyPool = keyPool
+		})
+	}
+
+	t.Run("pooled txs do not count against the queue of the key they are queued under", func(t *testing.T) {
+		require.NoError(t, txStore.CheckTxQueueCapacity(ctx, fromAddress, maxQueuedTransactions, &cltest.FixtureChainID))
+	})
+
+	t.Run("with equal or more pooled txs than limit returns error", func(t *testing.T) {
+		err := txStore.CheckPooledTxQueueCapacity(ctx, keyPool, maxQueuedTransactions, &cltest.FixtureChainID)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), fmt.Sprintf("cannot create transaction; too many unstarted transactions in the queue of the key pool (2/%d)", maxQueuedTransactions))
+
+		// pools sharing a key share its queue
+		err = txStore.CheckPooledTxQueueCapacity(ctx, []common.Address{otherAddress}, maxQueuedTransactions, &cltest.FixtureChainID)
+		require.Error(t, err)
+	})
+
+	t.Run("ignores pooled txs of other keys and chains", func(t *testing.T) {
+		require.NoError(t, txStore.CheckPooledTxQueueCapacity(ctx, []common.Address{testutils.NewAddress()}, maxQueuedTransactions, &cltest.FixtureChainID))
+		require.NoError(t, txStore.CheckPooledTxQueueCapacity(ctx, keyPool, maxQueuedTransactions, big.NewInt(42)))
+	})
+
+	t.Run("assigned txs no longer count", func(t *testing.T) {
+		etx, err := txStore.FindNextUnstartedPooledTx(ctx, fromAddress, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		require.NoError(t, txStore.AssignPooledTx(ctx, etx.ID, fromAddress, &cltest.FixtureChainID))
+
+		require.NoError(t, txStore.CheckPooledTxQueueCapacity(ctx, keyPool, maxQueuedTransactions, &cltest.FixtureChainID))
+	})
+
+	t.Run("disables check with 0 limit", func(t *testing.T) {
+		require.NoError(t, txStore.CheckPooledTxQueueCapacity(ctx, keyPool, 0, &cltest.FixtureChainID))
+	})
+}
+
 func TestORM_CreateTransaction(t *testing.T) {
 	t.Parallel()
 
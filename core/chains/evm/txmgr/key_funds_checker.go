@@ -0,0 +1,52 @@
+package txmgr
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+)
+
+var _ KeyFundsChecker = (*evmKeyFundsChecker)(nil)
+
+// evmKeyFundsChecker checks the latest balance of keys against the value of txs, plus the max fee of their
+// first attempt at the current fee estimate.
+type evmKeyFundsChecker struct {
+	client    evmclient.Client
+	feeConfig evmTxAttemptBuilderFeeConfig
+	estimator gas.EvmFeeEstimator
+}
+
+func NewEvmKeyFundsChecker(client evmclient.Client, feeConfig evmTxAttemptBuilderFeeConfig, estimator gas.EvmFeeEstimator) *evmKeyFundsChecker {
+	return &evmKeyFundsChecker{client, feeConfig, estimator}
+}
+
+func (c *evmKeyFundsChecker) HasSufficientFunds(ctx context.Context, address common.Address, etx Tx) (bool, error) {
+	feePolicy, err := etx.GetFeePolicy()
+	if err != nil {
+		return false, fmt.Errorf("failed to get fee policy: %w", err)
+	}
+	fee, gasLimit, err := c.estimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, maxGasPrice(c.feeConfig, address, feePolicy))
+	if err != nil {
+		return false, fmt.Errorf("failed to get fee: %w", err)
+	}
+	gasPrice := fee.Legacy
+	if c.feeConfig.EIP1559DynamicFees() && fee.ValidDynamic() {
+		gasPrice = fee.DynamicFeeCap
+	}
+	if gasPrice == nil {
+		return false, fmt.Errorf("estimator did not return a fee for %s", address)
+	}
+
+	cost := new(big.Int).Mul(gasPrice.ToInt(), new(big.Int).SetUint64(gasLimit))
+	cost.Add(cost, &etx.Value)
+	balance, err := c.client.BalanceAt(ctx, address, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get balance: %w", err)
+	}
+	return balance.Cmp(cost) >= 0, nil
+}
@@ -0,0 +1,77 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	gasmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestEvmKeyFundsChecker_HasSufficientFunds(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	address := testutils.NewAddress()
+	etx := txmgr.Tx{FeeLimit: 100, Value: *big.NewInt(1000)}
+
+	newClient := func(t *testing.T, balance int64) *evmclimocks.Client {
+		ethClient := evmclimocks.NewClient(t)
+		ethClient.On("BalanceAt", mock.Anything, address, (*big.Int)(nil)).Return(big.NewInt(balance), nil).Once()
+		return ethClient
+	}
+	newEstimator := func(t *testing.T, fee gas.EvmFee) *gasmocks.EvmFeeEstimator {
+		estimator := gasmocks.NewEvmFeeEstimator(t)
+		estimator.On("GetFee", mock.Anything, mock.Anything, uint64(100), assets.NewWeiI(50)).Return(fee, uint64(100), nil).Once()
+		return estimator
+	}
+	cfg := newFeeConfig()
+	cfg.priceMax = assets.NewWeiI(50)
+
+	t.Run("legacy fee", func(t *testing.T) {
+		// cost is 1000 + 100 * 10
+		for balance, expected := range map[int64]bool{2000: true, 1999: false} {
+			ethClient := newClient(t, balance)
+			checker := txmgr.NewEvmKeyFundsChecker(ethClient, cfg, newEstimator(t, gas.EvmFee{Legacy: assets.NewWeiI(10)}))
+			funded, err := checker.HasSufficientFunds(ctx, address, etx)
+			require.NoError(t, err)
+			assert.Equal(t, expected, funded)
+		}
+	})
+
+	t.Run("dynamic fee", func(t *testing.T) {
+		dynamicCfg := newFeeConfig()
+		dynamicCfg.priceMax = assets.NewWeiI(50)
+		dynamicCfg.eip1559DynamicFees = true
+		// cost is 1000 + 100 * 20
+		for balance, expected := range map[int64]bool{3000: true, 2999: false} {
+			ethClient := newClient(t, balance)
+			checker := txmgr.NewEvmKeyFundsChecker(ethClient, dynamicCfg, newEstimator(t, gas.EvmFee{DynamicTipCap: assets.NewWeiI(5), DynamicFeeCap: assets.NewWeiI(20)}))
+			funded, err := checker.HasSufficientFunds(ctx, address, etx)
+			require.NoError(t, err)
+			assert.Equal(t, expected, funded)
+		}
+	})
+
+	t.Run("caps fee at max fee price of fee policy", func(t *testing.T) {
+		feePolicy := sqlutil.JSON(`{"MaxFeePrice": 20}`)
+		policyTx := etx
+		policyTx.FeePolicy = &feePolicy
+		estimator := gasmocks.NewEvmFeeEstimator(t)
+		estimator.On("GetFee", mock.Anything, mock.Anything, uint64(100), assets.NewWeiI(20)).Return(gas.EvmFee{Legacy: assets.NewWeiI(20)}, uint64(100), nil).Once()
+		checker := txmgr.NewEvmKeyFundsChecker(newClient(t, 3000), cfg, estimator)
+		funded, err := checker.HasSufficientFunds(ctx, address, policyTx)
+		require.NoError(t, err)
+		assert.True(t, funded)
+	})
+}
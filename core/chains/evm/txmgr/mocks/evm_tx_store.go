@@ -46,6 +46,42 @@ func (_m *EvmTxStore) Abandon(ctx context.Context, id *big.Int, addr common.Addr
 	return r0
 }
 
+// AssignPooledTx provides a mock function with given fields: ctx, id, fromAddress, chainID
+func (_m *EvmTxStore) AssignPooledTx(ctx context.Context, id int64, fromAddress common.Address, chainID *big.Int) error {
+	ret := _m.Called(ctx, id, fromAddress, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignPooledTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, common.Address, *big.Int) error); ok {
+		r0 = rf(ctx, id, fromAddress, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CheckPooledTxQueueCapacity provides a mock function with given fields: ctx, keyPool, maxQueuedTransactions, chainID
+func (_m *EvmTxStore) CheckPooledTxQueueCapacity(ctx context.Context, keyPool []common.Address, maxQueuedTransactions uint64, chainID *big.Int) error {
+	ret := _m.Called(ctx, keyPool, maxQueuedTransactions, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckPooledTxQueueCapacity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Address, uint64, *big.Int) error); ok {
+		r0 = rf(ctx, keyPool, maxQueuedTransactions, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CheckTxQueueCapacity provides a mock function with given fields: ctx, fromAddress, maxQueuedTransactions, chainID
 func (_m *EvmTxStore) CheckTxQueueCapacity(ctx context.Context, fromAddress common.Address, maxQueuedTransactions uint64, chainID *big.Int) error {
 	ret := _m.Called(ctx, fromAddress, maxQueuedTransactions, chainID)
@@ -153,6 +189,34 @@ func (_m *EvmTxStore) CountUnstartedTransactions(ctx context.Context, fromAddres
 	return r0, r1
 }
 
+// CreateBatchTx provides a mock function with given fields: ctx, txRequest, ids, chainID
+func (_m *EvmTxStore) CreateBatchTx(ctx context.Context, txRequest types.TxRequest[common.Address, common.Hash], ids []int64, chainID *big.Int) (types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, txRequest, ids, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatchTx")
+	}
+
+	var r0 types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.TxRequest[common.Address, common.Hash], []int64, *big.Int) (types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, txRequest, ids, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.TxRequest[common.Address, common.Hash], []int64, *big.Int) types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, txRequest, ids, chainID)
+	} else {
+		r0 = ret.Get(0).(types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.TxRequest[common.Address, common.Hash], []int64, *big.Int) error); ok {
+		r1 = rf(ctx, txRequest, ids, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateTransaction provides a mock function with given fields: ctx, txRequest, chainID
 func (_m *EvmTxStore) CreateTransaction(ctx context.Context, txRequest types.TxRequest[common.Address, common.Hash], chainID *big.Int) (types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, txRequest, chainID)
@@ -199,6 +263,24 @@ func (_m *EvmTxStore) DeleteInProgressAttempt(ctx context.Context, attempt types
 	return r0
 }
 
+// DeleteUnstartedTx provides a mock function with given fields: ctx, id, chainID
+func (_m *EvmTxStore) DeleteUnstartedTx(ctx context.Context, id int64, chainID *big.Int) error {
+	ret := _m.Called(ctx, id, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUnstartedTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *big.Int) error); ok {
+		r0 = rf(ctx, id, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FindEarliestUnconfirmedBroadcastTime provides a mock function with given fields: ctx, chainID
 func (_m *EvmTxStore) FindEarliestUnconfirmedBroadcastTime(ctx context.Context, chainID *big.Int) (null.Time, error) {
 	ret := _m.Called(ctx, chainID)
@@ -283,6 +365,36 @@ func (_m *EvmTxStore) FindLatestSequence(ctx context.Context, fromAddress common
 	return r0, r1
 }
 
+// FindNextUnstartedPooledTx provides a mock function with given fields: ctx, fromAddress, chainID
+func (_m *EvmTxStore) FindNextUnstartedPooledTx(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, fromAddress, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindNextUnstartedPooledTx")
+	}
+
+	var r0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, fromAddress, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, fromAddress, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindNextUnstartedTransactionFromAddress provides a mock function with given fields: ctx, fromAddress, chainID
 func (_m *EvmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, fromAddress, chainID)
@@ -313,6 +425,36 @@ func (_m *EvmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Contex
 	return r0, r1
 }
 
+// FindOldestUnconfirmedTx provides a mock function with given fields: ctx, fromAddress, chainID
+func (_m *EvmTxStore) FindOldestUnconfirmedTx(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, fromAddress, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindOldestUnconfirmedTx")
+	}
+
+	var r0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, fromAddress, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, fromAddress, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindTransactionsConfirmedInBlockRange provides a mock function with given fields: ctx, highBlockNumber, lowBlockNumber, chainID
 func (_m *EvmTxStore) FindTransactionsConfirmedInBlockRange(ctx context.Context, highBlockNumber int64, lowBlockNumber int64, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, highBlockNumber, lowBlockNumber, chainID)
@@ -821,6 +963,36 @@ func (_m *EvmTxStore) FindTxsRequiringResubmissionDueToInsufficientFunds(ctx con
 	return r0, r1
 }
 
+// FindUnstartedBatchableTxs provides a mock function with given fields: ctx, fromAddress, chainID
+func (_m *EvmTxStore) FindUnstartedBatchableTxs(ctx context.Context, fromAddress common.Address, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, fromAddress, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUnstartedBatchableTxs")
+	}
+
+	var r0 []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, fromAddress, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, fromAddress, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAbandonedTransactionsByBatch provides a mock function with given fields: ctx, chainID, enabledAddrs, offset, limit
 func (_m *EvmTxStore) GetAbandonedTransactionsByBatch(ctx context.Context, chainID *big.Int, enabledAddrs []common.Address, offset uint, limit uint) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, chainID, enabledAddrs, offset, limit)
@@ -1051,6 +1223,36 @@ func (_m *EvmTxStore) MarkOldTxesMissingReceiptAsErrored(ctx context.Context, bl
 	return r0
 }
 
+// MarkPurgedTxsFatal provides a mock function with given fields: ctx, chainID
+func (_m *EvmTxStore) MarkPurgedTxsFatal(ctx context.Context, chainID *big.Int) ([]int64, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPurgedTxsFatal")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) ([]int64, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) []int64); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PreloadTxes provides a mock function with given fields: ctx, attempts
 func (_m *EvmTxStore) PreloadTxes(ctx context.Context, attempts []types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
 	ret := _m.Called(ctx, attempts)
@@ -1117,6 +1319,24 @@ func (_m *EvmTxStore) ReapTxHistory(ctx context.Context, minBlockNumberToKeep in
 	return r0
 }
 
+// SaveCancellationAttempt provides a mock function with given fields: ctx, etx, attempt
+func (_m *EvmTxStore) SaveCancellationAttempt(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
+	ret := _m.Called(ctx, etx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveCancellationAttempt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error); ok {
+		r0 = rf(ctx, etx, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveConfirmedMissingReceiptAttempt provides a mock function with given fields: ctx, timeout, attempt, broadcastAt
 func (_m *EvmTxStore) SaveConfirmedMissingReceiptAttempt(ctx context.Context, timeout time.Duration, attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], broadcastAt time.Time) error {
 	ret := _m.Called(ctx, timeout, attempt, broadcastAt)
@@ -1354,6 +1574,63 @@ func (_m *EvmTxStore) TxAttempts(ctx context.Context, offset int, limit int) ([]
 	return r0, r1, r2
 }
 
+// UnbatchTxs provides a mock function with given fields: ctx, ids, chainID
+func (_m *EvmTxStore) UnbatchTxs(ctx context.Context, ids []int64, chainID *big.Int) error {
+	ret := _m.Called(ctx, ids, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnbatchTxs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, *big.Int) error); ok {
+		r0 = rf(ctx, ids, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateBatchedTxs provides a mock function with given fields: ctx, chainID
+func (_m *EvmTxStore) UpdateBatchedTxs(ctx context.Context, chainID *big.Int) ([]int64, []int64, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBatchedTxs")
+	}
+
+	var r0 []int64
+	var r1 []int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) ([]int64, []int64, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) []int64); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) []int64); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *big.Int) error); ok {
+		r2 = rf(ctx, chainID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // UpdateBroadcastAts provides a mock function with given fields: ctx, now, etxIDs
 func (_m *EvmTxStore) UpdateBroadcastAts(ctx context.Context, now time.Time, etxIDs []int64) error {
 	ret := _m.Called(ctx, now, etxIDs)
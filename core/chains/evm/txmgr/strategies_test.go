@@ -2,13 +2,16 @@ package txmgr_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 )
@@ -49,3 +52,20 @@ func Test_DropOldestStrategy_PruneQueue(t *testing.T) {
 		assert.Equal(t, []int64{1, 2}, ids)
 	})
 }
+
+func Test_BatchStrategy(t *testing.T) {
+	t.Parallel()
+
+	subject := uuid.New()
+	contract := testutils.NewAddress()
+	s := txmgrcommon.NewBatchStrategy(subject, contract, time.Minute, 10)
+
+	assert.True(t, s.Subject().Valid)
+	assert.Equal(t, subject, s.Subject().UUID)
+
+	ids, err := s.PruneQueue(testutils.Context(t), nil)
+	assert.NoError(t, err)
+	assert.Len(t, ids, 0)
+
+	assert.Equal(t, txmgrtypes.TxBatchPolicy[common.Address]{Contract: contract, Window: time.Minute, MaxSize: 10}, s.BatchPolicy())
+}
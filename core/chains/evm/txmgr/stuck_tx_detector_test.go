@@ -0,0 +1,76 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func newStuckTx(broadcastBeforeBlockNums ...int64) *txmgr.Tx {
+	tx := &txmgr.Tx{ID: 1}
+	for i := range broadcastBeforeBlockNums {
+		tx.TxAttempts = append(tx.TxAttempts, txmgr.TxAttempt{ID: int64(i + 1), BroadcastBeforeBlockNum: &broadcastBeforeBlockNums[i]})
+	}
+	return tx
+}
+
+func TestStuckTxDetector_DetectStuckTransactions(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	chainID := big.NewInt(0)
+	fromAddress := testutils.NewAddress()
+	cfg := &txmgr.TestAutoPurgeConfig{IsEnabled: true, BlocksThreshold: 10, AttemptsRequired: 3}
+
+	newDetector := func(t *testing.T, tx *txmgr.Tx) *txmgr.StuckTxDetector {
+		txStore := mocks.NewEvmTxStore(t)
+		txStore.On("FindOldestUnconfirmedTx", mock.Anything, fromAddress, chainID).Return(tx, nil).Once()
+		return txmgr.NewEvmStuckTxDetector(logger.Test(t), txStore, cfg, chainID)
+	}
+
+	t.Run("returns the oldest tx if it has enough attempts and is old enough", func(t *testing.T) {
+		tx := newStuckTx(95, 90, 100)
+		stuckTxs, err := newDetector(t, tx).DetectStuckTransactions(ctx, []common.Address{fromAddress}, 100)
+		require.NoError(t, err)
+		require.Len(t, stuckTxs, 1)
+		assert.Equal(t, tx.ID, stuckTxs[0].ID)
+	})
+
+	t.Run("ignores tx first broadcast fewer than Threshold blocks ago", func(t *testing.T) {
+		stuckTxs, err := newDetector(t, newStuckTx(95, 91, 100)).DetectStuckTransactions(ctx, []common.Address{fromAddress}, 100)
+		require.NoError(t, err)
+		assert.Empty(t, stuckTxs)
+	})
+
+	t.Run("ignores tx with fewer than MinAttempts attempts", func(t *testing.T) {
+		stuckTxs, err := newDetector(t, newStuckTx(50, 60)).DetectStuckTransactions(ctx, []common.Address{fromAddress}, 100)
+		require.NoError(t, err)
+		assert.Empty(t, stuckTxs)
+	})
+
+	t.Run("ignores tx which was already cancelled or purged", func(t *testing.T) {
+		tx := newStuckTx(50, 60, 70)
+		meta := sqlutil.JSON(`{"CancelAttemptID": 3, "Purged": true}`)
+		tx.Meta = &meta
+		stuckTxs, err := newDetector(t, tx).DetectStuckTransactions(ctx, []common.Address{fromAddress}, 100)
+		require.NoError(t, err)
+		assert.Empty(t, stuckTxs)
+	})
+
+	t.Run("ignores address without unconfirmed txs", func(t *testing.T) {
+		stuckTxs, err := newDetector(t, nil).DetectStuckTransactions(ctx, []common.Address{fromAddress}, 100)
+		require.NoError(t, err)
+		assert.Empty(t, stuckTxs)
+	})
+}
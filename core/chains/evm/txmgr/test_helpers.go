@@ -48,6 +48,7 @@ type TestEvmConfig struct {
 	ResendAfterThreshold time.Duration
 	BumpThreshold        uint64
 	MaxQueued            uint64
+	AutoPurge            TestAutoPurgeConfig
 }
 
 func (e *TestEvmConfig) Transactions() evmconfig.Transactions {
@@ -118,12 +119,23 @@ type transactionsConfig struct {
 	e *TestEvmConfig
 }
 
-func (*transactionsConfig) ForwardersEnabled() bool               { return true }
-func (t *transactionsConfig) MaxInFlight() uint32                 { return t.e.MaxInFlight }
-func (t *transactionsConfig) MaxQueued() uint64                   { return t.e.MaxQueued }
-func (t *transactionsConfig) ReaperInterval() time.Duration       { return t.e.ReaperInterval }
-func (t *transactionsConfig) ReaperThreshold() time.Duration      { return t.e.ReaperThreshold }
-func (t *transactionsConfig) ResendAfterThreshold() time.Duration { return t.e.ResendAfterThreshold }
+func (*transactionsConfig) ForwardersEnabled() bool                { return true }
+func (t *transactionsConfig) MaxInFlight() uint32                  { return t.e.MaxInFlight }
+func (t *transactionsConfig) MaxQueued() uint64                    { return t.e.MaxQueued }
+func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.ReaperInterval }
+func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
+func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
+func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return &t.e.AutoPurge }
+
+type TestAutoPurgeConfig struct {
+	IsEnabled        bool
+	BlocksThreshold  uint32
+	AttemptsRequired uint32
+}
+
+func (a *TestAutoPurgeConfig) Enabled() bool       { return a.IsEnabled }
+func (a *TestAutoPurgeConfig) Threshold() uint32   { return a.BlocksThreshold }
+func (a *TestAutoPurgeConfig) MinAttempts() uint32 { return a.AttemptsRequired }
 
 type MockConfig struct {
 	EvmConfig           *TestEvmConfig
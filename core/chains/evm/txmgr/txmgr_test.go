@@ -3,6 +3,7 @@ package txmgr_test
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -378,6 +379,33 @@ func TestTxm_CreateTransaction(t *testing.T) {
 
 		assert.Equal(t, tx1.GetID(), tx2.GetID())
 	})
+
+	t.Run("rejects batched tx which cannot be batched", func(t *testing.T) {
+		evmConfig.MaxQueued = uint64(10)
+		strategy := txmgrcommon.NewBatchStrategy(uuid.New(), testutils.NewAddress(), time.Minute, 10)
+		_, err := txm.CreateTransaction(testutils.Context(t), txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			FeeLimit:       21000,
+			Value:          *big.NewInt(1),
+			Strategy:       strategy,
+		})
+		require.EqualError(t, err, "Txm#CreateTransaction: batched txs cannot transfer value")
+
+		etx, err := txm.CreateTransaction(testutils.Context(t), txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			FeeLimit:       21000,
+			Strategy:       strategy,
+		})
+		require.NoError(t, err)
+		policy, err := etx.GetBatchPolicy()
+		require.NoError(t, err)
+		require.NotNil(t, policy)
+		assert.Equal(t, strategy.BatchPolicy(), *policy)
+	})
 }
 
 func newMockTxStrategy(t *testing.T) *commontxmmocks.TxStrategy {
@@ -585,6 +613,82 @@ func TestTxm_Reset(t *testing.T) {
 	})
 }
 
+func TestTxm_Cancel(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	gcfg := configtest.NewTestGeneralConfig(t)
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+	kst := cltest.NewKeyStore(t, db)
+
+	_, addr := cltest.RandomKey{}.MustInsert(t, kst.Eth())
+	txStore := cltest.NewTestTxStore(t, db)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, nil)
+	ethClient.On("BatchCallContextAll", mock.Anything, mock.Anything).Return(nil).Maybe()
+	ethClient.On("PendingNonceAt", mock.Anything, addr).Return(uint64(128), nil).Maybe()
+
+	estimator := gas.NewEstimator(logger.Test(t), ethClient, cfg.EVM(), cfg.EVM().GasEstimator())
+	txm, err := makeTestEvmTxm(t, db, ethClient, estimator, cfg.EVM(), cfg.EVM().GasEstimator(), cfg.EVM().Transactions(), gcfg.Database(), gcfg.Database().Listener(), kst.Eth())
+	require.NoError(t, err)
+
+	unconfirmed := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, addr)
+	confirmed := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, addr)
+
+	t.Run("returns error if not started", func(t *testing.T) {
+		_, err := txm.Cancel(testutils.Context(t), unconfirmed.ID)
+		require.EqualError(t, err, "not started")
+	})
+
+	servicetest.Run(t, txm)
+
+	t.Run("deletes unstarted tx", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		etx := mustCreateUnstartedTx(t, txStore, addr, testutils.NewAddress(), []byte{1, 2, 3}, 21000, *big.NewInt(42), testutils.FixtureChainID)
+
+		_, err := txm.Cancel(ctx, etx.ID)
+		require.NoError(t, err)
+
+		_, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("replaces unconfirmed tx with an empty tx at a bumped fee", func(t *testing.T) {
+		ctx := testutils.Context(t)
+
+		etx, err := txm.Cancel(ctx, unconfirmed.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.TxAttempts, 2)
+		assert.Equal(t, addr, etx.ToAddress)
+		assert.Equal(t, int64(0), etx.Value.Int64())
+		assert.Empty(t, etx.EncodedPayload)
+		assert.Equal(t, unconfirmed.Sequence, etx.Sequence)
+		assert.Equal(t, txmgrtypes.TxAttemptInProgress, etx.TxAttempts[0].State)
+		assert.True(t, etx.TxAttempts[0].TxFee.Legacy.Cmp(unconfirmed.TxAttempts[0].TxFee.Legacy) > 0)
+
+		etx, err = txStore.FindTxWithAttempts(ctx, unconfirmed.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+		meta, err := etx.GetMeta()
+		require.NoError(t, err)
+		require.NotNil(t, meta.CancelAttemptID)
+
+		_, err = txm.Cancel(ctx, unconfirmed.ID)
+		require.ErrorIs(t, err, txmgrcommon.ErrTxNotCancellable)
+	})
+
+	t.Run("does not cancel confirmed tx", func(t *testing.T) {
+		_, err := txm.Cancel(testutils.Context(t), confirmed.ID)
+		require.ErrorIs(t, err, txmgrcommon.ErrTxNotCancellable)
+	})
+
+	t.Run("returns error for nonexistent tx", func(t *testing.T) {
+		_, err := txm.Cancel(testutils.Context(t), 0)
+		require.ErrorIs(t, err, txmgrcommon.ErrTxNotFound)
+	})
+}
+
 func newTxStore(t *testing.T, db *sqlx.DB) txmgr.EvmTxStore {
 	return txmgr.NewTxStore(db, logger.Test(t))
 }
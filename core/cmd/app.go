@@ -310,6 +310,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing forwarder addresses.",
 			Subcommands: initFowardersSubCmds(s),
 		},
+		{
+			Name:        "workflows",
+			Usage:       "Commands for inspecting and simulating workflows",
+			Subcommands: initWorkflowsSubCmds(s),
+		},
 		{
 			Name:  "help-all",
 			Usage: "Shows a list of all commands and sub-commands",
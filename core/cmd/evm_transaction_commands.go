@@ -62,6 +62,11 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "cancel",
+				Usage:  "Cancel the unstarted or unconfirmed Ethereum Transaction with the given ID",
+				Action: s.CancelTransaction,
+			},
 		},
 	}
 }
@@ -131,6 +136,26 @@ func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// CancelTransaction cancels the transaction with the given ID
+func (s *Shell) CancelTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the transaction"))
+	}
+	id := c.Args().First()
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/transactions/evm/"+id+"/cancel", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (s *Shell) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"math/big"
+	"strconv"
 	"testing"
 	"time"
 
@@ -89,6 +90,29 @@ func TestShell_ShowTransaction(t *testing.T) {
 	assert.Equal(t, &tx.FromAddress, renderedTx.From)
 }
 
+func TestShell_CancelTransaction(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, from)
+
+	set := flag.NewFlagSet("test cancel tx", 0)
+	flagSetApplyFromAction(client.CancelTransaction, set, "")
+
+	require.NoError(t, set.Parse([]string{strconv.FormatInt(tx.ID, 10)}))
+
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.CancelTransaction(c))
+
+	renderedTx := *r.Renders[0].(*cmd.EthTxPresenter)
+	assert.Equal(t, &from, renderedTx.To)
+}
+
 func TestShell_IndexTxAttempts(t *testing.T) {
 	t.Parallel()
 
@@ -2,12 +2,16 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -50,6 +54,35 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:      "test",
+			Usage:     "Run the pipeline of a job spec against test cases with mocked http, bridge and ethcall tasks, without a node",
+			ArgsUsage: "<spec>",
+			Action:    s.TestPipelineSpec,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "fixtures, f",
+					Usage: "YAML or JSON file containing the test cases: the input vars, mocked task results and expected outputs or errors of each run",
+				},
+				cli.DurationFlag{
+					Name:  "timeout",
+					Usage: "maximum duration of all test runs",
+					Value: time.Minute,
+				},
+			},
+		},
+		{
+			Name:  "runs",
+			Usage: "Commands for job runs",
+			Subcommands: []cli.Command{
+				{
+					Name:      "replay",
+					Usage:     "Re-execute a finished run with its original inputs and recorded http, bridge and ethcall results, and show the differences from the original run",
+					ArgsUsage: "<runID>",
+					Action:    s.ReplayPipelineRun,
+				},
+			},
+		},
 	}
 }
 
@@ -309,3 +342,169 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PipelineRunReplayPresenter wraps the JSONAPI Pipeline Run Replay Resource and adds rendering functionality
+type PipelineRunReplayPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.PipelineRunReplayResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineRunReplayPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Original", "Replay", "Changed"})
+	for _, tr := range p.TaskRuns {
+		taskType := string(tr.Type)
+		if tr.Recorded {
+			taskType += " (recorded)"
+		}
+		table.Append([]string{
+			tr.DotID,
+			taskType,
+			formatTaskRunResult(tr.OriginalOutput, tr.OriginalError, tr.OriginalSkipped),
+			formatTaskRunResult(tr.Output, tr.Error, tr.Skipped),
+			formatChanged(tr.Changed),
+		})
+	}
+	render(fmt.Sprintf("Replay of Job Run %s", p.ID), table)
+
+	outputsTable := rt.newTable([]string{"Output", "Original", "Replay", "Changed"})
+	for i := 0; i < len(p.Outputs) || i < len(p.OriginalOutputs); i++ {
+		original := formatTaskRunResult(stringPtrAt(p.OriginalOutputs, i), stringPtrAt(p.OriginalFatalErrors, i), false)
+		replay := formatTaskRunResult(stringPtrAt(p.Outputs, i), stringPtrAt(p.FatalErrors, i), false)
+		outputsTable.Append([]string{strconv.Itoa(i), original, replay, formatChanged(original != replay)})
+	}
+	render("Outputs", outputsTable)
+	return nil
+}
+
+func formatTaskRunResult(output, err *string, skipped bool) string {
+	switch {
+	case skipped:
+		return "skipped"
+	case err != nil:
+		return "error: " + *err
+	case output != nil:
+		return *output
+	default:
+		return ""
+	}
+}
+
+func formatChanged(changed bool) string {
+	if changed {
+		return "yes"
+	}
+	return ""
+}
+
+func stringPtrAt(ss []*string, i int) *string {
+	if i < len(ss) {
+		return ss[i]
+	}
+	return nil
+}
+
+// ReplayPipelineRun re-executes a finished job run and shows the differences from the original run
+func (s *Shell) ReplayPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("Must pass the run id to replay"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/pipeline/runs/"+c.Args().First()+"/replay", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineRunReplayPresenter{})
+}
+
+// PipelineSpecTestPresenters renders the results of pipeline spec tests
+type PipelineSpecTestPresenters []pipeline.SpecTestResult
+
+// RenderTable implements TableRenderer
+func (ps PipelineSpecTestPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Test", "Result", "Outputs", "Failures"})
+	for _, p := range ps {
+		result := "PASS"
+		if !p.Passed() {
+			result = "FAIL"
+		}
+		var outputs []string
+		if p.Run != nil {
+			values, _ := p.Run.Outputs.Val.([]interface{})
+			for i, output := range values {
+				if i < len(p.Run.FatalErrors) && p.Run.FatalErrors[i].Valid {
+					outputs = append(outputs, "error: "+p.Run.FatalErrors[i].String)
+				} else {
+					b, _ := json.Marshal(output)
+					outputs = append(outputs, string(b))
+				}
+			}
+		}
+		table.Append([]string{p.Name, result, strings.Join(outputs, "\n"), strings.Join(p.Failures, "\n")})
+	}
+
+	render("Pipeline Spec Tests", table)
+	return nil
+}
+
+// TestPipelineSpec runs the pipeline of a job spec, or a DOT pipeline, against the test cases in a fixtures file
+func (s *Shell) TestPipelineSpec(c *cli.Context) error {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the path of the job spec"))
+	}
+	spec, err := os.ReadFile(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	path := c.String("fixtures")
+	if path == "" {
+		return s.errorOut(errors.New("must provide the path of the fixtures file with --fixtures"))
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	fixture, err := pipeline.ParseSpecTestFixture(string(b))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to parse fixtures"))
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx(), c.Duration("timeout"))
+	defer cancel()
+	results, err := pipeline.RunSpecTests(ctx, s.Logger, observationSource(string(spec)), fixture)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to run spec tests"))
+	}
+
+	if err = s.Render(PipelineSpecTestPresenters(results)); err != nil {
+		return s.errorOut(err)
+	}
+
+	var failed int
+	for _, result := range results {
+		if !result.Passed() {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return s.errorOut(errors.Errorf("%d of %d spec tests failed", failed, len(results)))
+	}
+	return nil
+}
+
+// observationSource returns the pipeline of a TOML job spec, or the spec itself if it's a DOT pipeline.
+func observationSource(spec string) string {
+	tree, err := toml.Load(spec)
+	if err != nil {
+		return spec
+	}
+	if source, ok := tree.Get("observationSource").(string); ok {
+		return source
+	}
+	return spec
+}
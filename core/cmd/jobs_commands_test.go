@@ -5,6 +5,8 @@ import (
 	_ "embed"
 	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -88,6 +91,44 @@ func TestJobPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, createdAt.Format(time.RFC3339))
 }
 
+func TestPipelineRunReplayPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	recorded := `"{\"price\":10}"`
+	original, replayed := `"20"`, `"30"`
+	p := cmd.PipelineRunReplayPresenter{
+		JAID: cmd.NewJAID("42"),
+		PipelineRunReplayResource: presenters.PipelineRunReplayResource{
+			Outputs:         []*string{&replayed},
+			OriginalOutputs: []*string{&original},
+			TaskRuns: []presenters.PipelineTaskRunReplayResource{
+				{DotID: "ds", Type: "http", Recorded: true, Output: &recorded, OriginalOutput: &recorded},
+				{DotID: "multiply", Type: "multiply", Output: &replayed, OriginalOutput: &original, Changed: true},
+			},
+		},
+	}
+
+	tests := []struct {
+		name, content string
+	}{
+		{"Task", "multiply"},
+		{"Recorded", "http (recorded)"},
+		{"Original", original},
+		{"Replay", replayed},
+		{"Changed", "yes"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tw := &testWriter{test.content, t, false}
+			r := cmd.RendererTable{Writer: tw}
+
+			assert.NoError(t, r.Render(&p))
+			assert.True(t, tw.found)
+		})
+	}
+}
+
 func TestJobRenderer_GetTasks(t *testing.T) {
 	t.Parallel()
 
@@ -458,3 +499,57 @@ func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	require.NoError(t, err)
 	require.Len(t, jobs, expected)
 }
+
+const testedPipelineSpec = `
+type            = "webhook"
+schemaVersion   = 1
+observationSource = """
+ds       [type=http method=GET url="https://example.com/eth-usd"];
+ds_parse [type=jsonparse path="price"];
+ds -> ds_parse;
+"""
+`
+
+func TestShell_TestPipelineSpec(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		expected string
+		err      string
+	}{
+		{"passing", "3000.5", ""},
+		{"failing", "1", "1 of 1 spec tests failed"},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			specPath := filepath.Join(dir, "spec.toml")
+			require.NoError(t, os.WriteFile(specPath, []byte(testedPipelineSpec), 0600))
+			fixturesPath := filepath.Join(dir, "fixtures.yaml")
+			fixtures := fmt.Sprintf("tests:\n  - name: price\n    mocks:\n      ds:\n        value: {price: 3000.5}\n    outputs: [%s]\n", test.expected)
+			require.NoError(t, os.WriteFile(fixturesPath, []byte(fixtures), 0600))
+
+			r := &cltest.RendererMock{}
+			client := &cmd.Shell{Renderer: r, Logger: logger.TestLogger(t)}
+
+			set := flag.NewFlagSet("test", 0)
+			flagSetApplyFromAction(client.TestPipelineSpec, set, "")
+			require.NoError(t, set.Set("fixtures", fixturesPath))
+			require.NoError(t, set.Parse([]string{specPath}))
+
+			err := client.TestPipelineSpec(cli.NewContext(nil, set, nil))
+			if test.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.err)
+			}
+			require.Len(t, r.Renders, 1)
+			results := r.Renders[0].(cmd.PipelineSpecTestPresenters)
+			require.Len(t, results, 1)
+			assert.Equal(t, test.err == "", results[0].Passed())
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initWorkflowsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "executions",
+			Usage: "Commands for inspecting workflow executions",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List workflow executions, most recent first",
+					Action: s.ListWorkflowExecutions,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+						cli.StringFlag{
+							Name:  "workflow-id",
+							Usage: "only list executions of the given workflow",
+						},
+						cli.StringFlag{
+							Name:  "status",
+							Usage: "only list executions with the given status, options: [started, completed, errored, timeout]",
+						},
+					},
+				},
+				{
+					Name:   "show",
+					Usage:  "Show a workflow execution, including the inputs, outputs and errors of each step",
+					Action: s.ShowWorkflowExecution,
+				},
+			},
+		},
+		{
+			Name:      "simulate",
+			Usage:     "Run a workflow spec against mocked capabilities, without deploying it",
+			ArgsUsage: "<workflow.yaml>",
+			Action:    s.SimulateWorkflow,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "fixtures, f",
+					Usage: "YAML or JSON file containing the trigger payload and the response of each capability",
+				},
+				cli.DurationFlag{
+					Name:  "timeout",
+					Usage: "maximum duration of the simulated execution",
+					Value: time.Minute,
+				},
+			},
+		},
+	}
+}
+
+// WorkflowExecutionPresenter wraps the JSONAPI Workflow Execution Resource and adds rendering functionality
+type WorkflowExecutionPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.WorkflowExecutionResource
+}
+
+// ToRow returns the execution as a summary row
+func (p WorkflowExecutionPresenter) ToRow() []string {
+	return []string{
+		p.GetID(),
+		p.WorkflowID,
+		p.Status,
+		formatOptionalTime(p.CreatedAt),
+		formatOptionalTime(p.FinishedAt),
+	}
+}
+
+var workflowExecutionHeaders = []string{"ID", "Workflow ID", "Status", "Created At", "Finished At"}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowExecutionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable(workflowExecutionHeaders)
+	table.Append(p.ToRow())
+	render("Workflow Execution", table)
+
+	stepsTable := rt.newTable([]string{"Ref", "Status", "Inputs", "Outputs", "Error", "Attempts", "Updated At"})
+	for _, step := range p.Steps {
+		stepsTable.Append([]string{
+			step.Ref,
+			step.Status,
+			formatOptionalString(step.Inputs),
+			formatOptionalString(step.Outputs),
+			formatOptionalString(step.Error),
+			strconv.Itoa(len(step.Attempts)),
+			formatOptionalTime(step.UpdatedAt),
+		})
+	}
+	render("Steps", stepsTable)
+	return nil
+}
+
+type WorkflowExecutionPresenters []WorkflowExecutionPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowExecutionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(workflowExecutionHeaders)
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Workflow Executions", table)
+	return nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func formatOptionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// ListWorkflowExecutions lists workflow executions, optionally filtered by workflow ID and status
+func (s *Shell) ListWorkflowExecutions(c *cli.Context) (err error) {
+	q := url.Values{}
+	if workflowID := c.String("workflow-id"); workflowID != "" {
+		q.Set("workflowID", workflowID)
+	}
+	if status := c.String("status"); status != "" {
+		q.Set("status", status)
+	}
+	uri := url.URL{Path: "/v2/workflows/executions", RawQuery: q.Encode()}
+
+	return s.getPage(uri.String(), c.Int("page"), &WorkflowExecutionPresenters{})
+}
+
+// ShowWorkflowExecution displays the details of a workflow execution
+func (s *Shell) ShowWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the workflow execution"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/workflows/executions/"+url.PathEscape(c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{})
+}
+
+// SimulateWorkflow executes a workflow spec once, with every capability replaced by a mock
+// returning its response from the fixtures file, and displays each step's inputs and outputs
+func (s *Shell) SimulateWorkflow(c *cli.Context) error {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the path of the workflow spec"))
+	}
+	spec, err := os.ReadFile(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	fixtures := workflows.SimulationFixtures{}
+	if path := c.String("fixtures"); path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return s.errorOut(err)
+		}
+		fixtures, err = workflows.ParseSimulationFixtures(string(b))
+		if err != nil {
+			return s.errorOut(fmt.Errorf("failed to parse fixtures: %w", err))
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx(), c.Duration("timeout"))
+	defer cancel()
+	result, err := workflows.Simulate(ctx, s.Logger, string(spec), fixtures)
+	if err != nil {
+		return s.errorOut(fmt.Errorf("failed to simulate workflow: %w", err))
+	}
+
+	resource := presenters.NewWorkflowExecutionResource(result.Execution, s.Logger)
+	resource.Steps = orderSteps(resource.Steps, result.StepRefs)
+	return s.errorOut(s.Render(&WorkflowExecutionPresenter{
+		JAID:                      NewJAID(resource.GetID()),
+		WorkflowExecutionResource: resource,
+	}))
+}
+
+// orderSteps sorts the steps by their position in refs, omitting steps which were not executed.
+func orderSteps(steps []presenters.WorkflowExecutionStepResource, refs []string) []presenters.WorkflowExecutionStepResource {
+	byRef := map[string]presenters.WorkflowExecutionStepResource{}
+	for _, step := range steps {
+		byRef[step.Ref] = step
+	}
+
+	ordered := []presenters.WorkflowExecutionStepResource{}
+	for _, ref := range refs {
+		if step, ok := byRef[ref]; ok {
+			ordered = append(ordered, step)
+		}
+	}
+	return ordered
+}
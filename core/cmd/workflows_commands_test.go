@@ -0,0 +1,140 @@
+package cmd_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	webpresenters "github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestRendererTable_RenderWorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	inputs := `{"report":"0x01"}`
+	stepErr := "fatal consensus error"
+	we := cmd.WorkflowExecutionPresenter{
+		JAID: cmd.NewJAID("execution-1"),
+		WorkflowExecutionResource: webpresenters.WorkflowExecutionResource{
+			WorkflowID: "workflow-1",
+			Status:     "errored",
+			Steps: []webpresenters.WorkflowExecutionStepResource{
+				{
+					Ref:    "evm_median",
+					Status: "errored",
+					Inputs: &inputs,
+					Error:  &stepErr,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name, content string
+	}{
+		{"ID", "execution-1"},
+		{"WorkflowID", "workflow-1"},
+		{"Ref", "evm_median"},
+		{"Inputs", inputs},
+		{"Error", stepErr},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tw := &testWriter{test.content, t, false}
+			r := cmd.RendererTable{Writer: tw}
+
+			assert.NoError(t, r.Render(&we))
+			assert.True(t, tw.found)
+		})
+	}
+}
+
+const simulatedWorkflow = `
+triggers:
+  - id: "mercury-trigger"
+    config:
+      feedIds:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000"
+
+consensus:
+  - id: "offchain_reporting"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+
+targets:
+  - id: "write_ethereum-testnet-sepolia"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x54e220867af6683aE6DcBF535B4f952cB5116510"
+`
+
+const simulatedWorkflowFixtures = `
+trigger:
+  price: 1.25
+capabilities:
+  offchain_reporting:
+    outputs:
+      report: "0xabcd"
+  write_ethereum-testnet-sepolia: {}
+`
+
+func TestShell_SimulateWorkflow(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "workflow.yaml")
+	require.NoError(t, os.WriteFile(specPath, []byte(simulatedWorkflow), 0600))
+	fixturesPath := filepath.Join(dir, "fixtures.yaml")
+	require.NoError(t, os.WriteFile(fixturesPath, []byte(simulatedWorkflowFixtures), 0600))
+
+	r := &cltest.RendererMock{}
+	client := &cmd.Shell{Renderer: r, Logger: logger.TestLogger(t)}
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.SimulateWorkflow, set, "")
+	require.NoError(t, set.Set("fixtures", fixturesPath))
+	require.NoError(t, set.Parse([]string{specPath}))
+
+	require.NoError(t, client.SimulateWorkflow(cli.NewContext(nil, set, nil)))
+	require.Len(t, r.Renders, 1)
+
+	we := r.Renders[0].(*cmd.WorkflowExecutionPresenter)
+	assert.Equal(t, "completed", we.Status)
+	refs := []string{}
+	for _, step := range we.Steps {
+		refs = append(refs, step.Ref)
+	}
+	assert.Equal(t, []string{"trigger", "evm_median", "write_ethereum-testnet-sepolia"}, refs)
+	require.NotNil(t, we.Steps[1].Outputs)
+	assert.JSONEq(t, `{"report":"0xabcd"}`, *we.Steps[1].Outputs)
+}
+
+func TestShell_SimulateWorkflow_MissingFixtures(t *testing.T) {
+	t.Parallel()
+
+	specPath := filepath.Join(t.TempDir(), "workflow.yaml")
+	require.NoError(t, os.WriteFile(specPath, []byte(simulatedWorkflow), 0600))
+
+	client := &cmd.Shell{Renderer: &cltest.RendererMock{}, Logger: logger.TestLogger(t)}
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.SimulateWorkflow, set, "")
+	require.NoError(t, set.Parse([]string{specPath}))
+
+	err := client.SimulateWorkflow(cli.NewContext(nil, set, nil))
+	assert.ErrorContains(t, err, "no fixture defined for capability offchain_reporting")
+}
@@ -1,6 +1,21 @@
 package config
 
+import (
+	"time"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+)
+
 type Capabilities interface {
 	Peering() P2P
+	Workflows() CapabilitiesWorkflows
+	// RemoteCapabilities returns the action, consensus and target capabilities hosted by the capability DON
+	RemoteCapabilities() []commoncap.CapabilityInfo
 	// NOTE: RegistrySyncer will need config with relay ID, chain ID and contract address when implemented
 }
+
+type CapabilitiesWorkflows interface {
+	ReaperInterval() time.Duration
+	ReaperThreshold() time.Duration
+	ReaperMaxExecutions() uint32
+}
@@ -16,4 +16,13 @@ type JobPipeline interface {
 	ResultWriteQueueDepth() uint64
 	ExternalInitiatorsEnabled() bool
 	VerboseLogging() bool
+	HTTPHostLimits() map[string]JobPipelineRequestLimit
+	HTTPBridgeLimits() map[string]JobPipelineRequestLimit
+}
+
+// JobPipelineRequestLimit limits the requests made to a host or bridge by `http` and `bridge` tasks, across all runs.
+// Zero values are unlimited.
+type JobPipelineRequestLimit struct {
+	MaxConcurrent        uint32
+	MaxRequestsPerSecond float64
 }
@@ -14,6 +14,7 @@ import (
 
 	ocrcommontypes "github.com/smartcontractkit/libocr/commontypes"
 
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 
 	"github.com/smartcontractkit/chainlink/v2/core/build"
@@ -886,6 +887,9 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 type JobPipelineHTTPRequest struct {
 	DefaultTimeout *commonconfig.Duration
 	MaxSize        *utils.FileSize
+
+	HostLimits   []JobPipelineHostLimit   `toml:",omitempty"`
+	BridgeLimits []JobPipelineBridgeLimit `toml:",omitempty"`
 }
 
 func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
@@ -895,6 +899,70 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	if v := f.MaxSize; v != nil {
 		j.MaxSize = v
 	}
+	if v := f.HostLimits; v != nil {
+		j.HostLimits = v
+	}
+	if v := f.BridgeLimits; v != nil {
+		j.BridgeLimits = v
+	}
+}
+
+func (j *JobPipelineHTTPRequest) ValidateConfig() (err error) {
+	hosts := make(map[string]struct{}, len(j.HostLimits))
+	for _, l := range j.HostLimits {
+		if l.Host == nil || *l.Host == "" {
+			err = multierr.Append(err, configutils.ErrEmpty{Name: "HostLimits.Host", Msg: "must be provided and non-empty"})
+		} else {
+			host := strings.ToLower(*l.Host)
+			if _, exists := hosts[host]; exists {
+				err = multierr.Append(err, configutils.NewErrDuplicate("HostLimits.Host", *l.Host))
+			}
+			hosts[host] = struct{}{}
+		}
+		err = multierr.Append(err, validateJobPipelineLimit("HostLimits", l.MaxConcurrent, l.MaxRequestsPerSecond))
+	}
+
+	bridges := make(map[string]struct{}, len(j.BridgeLimits))
+	for _, l := range j.BridgeLimits {
+		if l.Bridge == nil || *l.Bridge == "" {
+			err = multierr.Append(err, configutils.ErrEmpty{Name: "BridgeLimits.Bridge", Msg: "must be provided and non-empty"})
+		} else {
+			bridge := strings.ToLower(*l.Bridge)
+			if _, exists := bridges[bridge]; exists {
+				err = multierr.Append(err, configutils.NewErrDuplicate("BridgeLimits.Bridge", *l.Bridge))
+			}
+			bridges[bridge] = struct{}{}
+		}
+		err = multierr.Append(err, validateJobPipelineLimit("BridgeLimits", l.MaxConcurrent, l.MaxRequestsPerSecond))
+	}
+	return err
+}
+
+func validateJobPipelineLimit(name string, maxConcurrent *uint32, maxRequestsPerSecond *float64) (err error) {
+	if maxConcurrent == nil && maxRequestsPerSecond == nil {
+		return configutils.ErrMissing{Name: name + ".MaxConcurrent", Msg: "either MaxConcurrent or MaxRequestsPerSecond must be set"}
+	}
+	if maxConcurrent != nil && *maxConcurrent == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: name + ".MaxConcurrent", Value: *maxConcurrent, Msg: "must be greater than zero"})
+	}
+	if maxRequestsPerSecond != nil && !(*maxRequestsPerSecond > 0) {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: name + ".MaxRequestsPerSecond", Value: *maxRequestsPerSecond, Msg: "must be greater than zero"})
+	}
+	return err
+}
+
+// JobPipelineHostLimit limits the requests made to a host by `http` and `bridge` tasks.
+type JobPipelineHostLimit struct {
+	Host                 *string
+	MaxConcurrent        *uint32
+	MaxRequestsPerSecond *float64
+}
+
+// JobPipelineBridgeLimit limits the requests made to a bridge by `bridge` tasks.
+type JobPipelineBridgeLimit struct {
+	Bridge               *string
+	MaxConcurrent        *uint32
+	MaxRequestsPerSecond *float64
 }
 
 type FluxMonitor struct {
@@ -1407,11 +1475,77 @@ func (m *MercurySecrets) ValidateConfig() (err error) {
 }
 
 type Capabilities struct {
-	Peering P2P `toml:",omitempty"`
+	Peering            P2P                            `toml:",omitempty"`
+	Workflows          CapabilitiesWorkflows          `toml:",omitempty"`
+	RemoteCapabilities []CapabilitiesRemoteCapability `toml:",omitempty"`
 }
 
 func (c *Capabilities) setFrom(f *Capabilities) {
 	c.Peering.setFrom(&f.Peering)
+	c.Workflows.setFrom(&f.Workflows)
+	if v := f.RemoteCapabilities; v != nil {
+		c.RemoteCapabilities = v
+	}
+}
+
+func (c *Capabilities) ValidateConfig() (err error) {
+	ids := make(map[string]struct{}, len(c.RemoteCapabilities))
+	for _, r := range c.RemoteCapabilities {
+		if r.ID == nil || *r.ID == "" {
+			err = multierr.Append(err, configutils.ErrEmpty{Name: "RemoteCapabilities.ID", Msg: "must be provided and non-empty"})
+		} else {
+			if _, exists := ids[*r.ID]; exists {
+				err = multierr.Append(err, configutils.NewErrDuplicate("RemoteCapabilities.ID", *r.ID))
+			}
+			ids[*r.ID] = struct{}{}
+		}
+		if r.Type == nil {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "RemoteCapabilities.Type", Msg: "must be one of action, consensus or target"})
+		} else if _, ok := r.CapabilityType(); !ok {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "RemoteCapabilities.Type", Value: *r.Type, Msg: "must be one of action, consensus or target"})
+		}
+	}
+	return err
+}
+
+// CapabilitiesRemoteCapability is an action, consensus or target capability hosted by the capability DON.
+type CapabilitiesRemoteCapability struct {
+	ID   *string
+	Type *string
+}
+
+// CapabilityType returns the type of the capability, and false if it can't be called remotely.
+func (r *CapabilitiesRemoteCapability) CapabilityType() (commoncap.CapabilityType, bool) {
+	if r.Type == nil {
+		return 0, false
+	}
+	switch *r.Type {
+	case "action":
+		return commoncap.CapabilityTypeAction, true
+	case "consensus":
+		return commoncap.CapabilityTypeConsensus, true
+	case "target":
+		return commoncap.CapabilityTypeTarget, true
+	}
+	return 0, false
+}
+
+type CapabilitiesWorkflows struct {
+	ReaperInterval      *commonconfig.Duration
+	ReaperThreshold     *commonconfig.Duration
+	ReaperMaxExecutions *uint32
+}
+
+func (w *CapabilitiesWorkflows) setFrom(f *CapabilitiesWorkflows) {
+	if v := f.ReaperInterval; v != nil {
+		w.ReaperInterval = v
+	}
+	if v := f.ReaperThreshold; v != nil {
+		w.ReaperThreshold = v
+	}
+	if v := f.ReaperMaxExecutions; v != nil {
+		w.ReaperMaxExecutions = v
+	}
 }
 
 type ThresholdKeyShareSecrets struct {
@@ -310,6 +310,115 @@ func TestTracing_ValidateCollectorTarget(t *testing.T) {
 	}
 }
 
+func TestJobPipelineHTTPRequest_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		request JobPipelineHTTPRequest
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			request: JobPipelineHTTPRequest{
+				HostLimits:   []JobPipelineHostLimit{{Host: ptr("api.example.com"), MaxConcurrent: ptr[uint32](10)}},
+				BridgeLimits: []JobPipelineBridgeLimit{{Bridge: ptr("coinmarketcap"), MaxRequestsPerSecond: ptr(0.5)}},
+			},
+		},
+		{
+			name: "duplicate host",
+			request: JobPipelineHTTPRequest{
+				HostLimits: []JobPipelineHostLimit{
+					{Host: ptr("api.example.com"), MaxConcurrent: ptr[uint32](10)},
+					{Host: ptr("API.example.com"), MaxConcurrent: ptr[uint32](5)},
+				},
+			},
+			errMsg: "HostLimits.Host: invalid value (API.example.com): duplicate - must be unique",
+		},
+		{
+			name: "empty bridge",
+			request: JobPipelineHTTPRequest{
+				BridgeLimits: []JobPipelineBridgeLimit{{MaxConcurrent: ptr[uint32](10)}},
+			},
+			errMsg: "BridgeLimits.Bridge: empty: must be provided and non-empty",
+		},
+		{
+			name: "no limits",
+			request: JobPipelineHTTPRequest{
+				HostLimits: []JobPipelineHostLimit{{Host: ptr("api.example.com")}},
+			},
+			errMsg: "HostLimits.MaxConcurrent: missing: either MaxConcurrent or MaxRequestsPerSecond must be set",
+		},
+		{
+			name: "zero limits",
+			request: JobPipelineHTTPRequest{
+				HostLimits: []JobPipelineHostLimit{{Host: ptr("api.example.com"), MaxConcurrent: ptr[uint32](0), MaxRequestsPerSecond: ptr(0.0)}},
+			},
+			errMsg: "HostLimits.MaxConcurrent: invalid value (0): must be greater than zero; HostLimits.MaxRequestsPerSecond: invalid value (0): must be greater than zero",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestCapabilities_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		capabilities Capabilities
+		errMsg       string
+	}{
+		{
+			name: "valid",
+			capabilities: Capabilities{RemoteCapabilities: []CapabilitiesRemoteCapability{
+				{ID: ptr("offchain_reporting@1.0.0"), Type: ptr("consensus")},
+				{ID: ptr("write_ethereum@1.0.0"), Type: ptr("target")},
+			}},
+		},
+		{
+			name: "duplicate id",
+			capabilities: Capabilities{RemoteCapabilities: []CapabilitiesRemoteCapability{
+				{ID: ptr("offchain_reporting@1.0.0"), Type: ptr("consensus")},
+				{ID: ptr("offchain_reporting@1.0.0"), Type: ptr("action")},
+			}},
+			errMsg: "RemoteCapabilities.ID: invalid value (offchain_reporting@1.0.0): duplicate - must be unique",
+		},
+		{
+			name: "empty id",
+			capabilities: Capabilities{RemoteCapabilities: []CapabilitiesRemoteCapability{
+				{Type: ptr("consensus")},
+			}},
+			errMsg: "RemoteCapabilities.ID: empty: must be provided and non-empty",
+		},
+		{
+			name: "trigger",
+			capabilities: Capabilities{RemoteCapabilities: []CapabilitiesRemoteCapability{
+				{ID: ptr("mercury-trigger"), Type: ptr("trigger")},
+			}},
+			errMsg: "RemoteCapabilities.Type: invalid value (trigger): must be one of action, consensus or target",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.capabilities.ValidateConfig()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+			}
+		})
+	}
+}
+
 func TestTracing_ValidateSamplingRatio(t *testing.T) {
 	tests := []struct {
 		name          string
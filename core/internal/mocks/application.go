@@ -13,6 +13,8 @@ import (
 
 	context "context"
 
+	core "github.com/smartcontractkit/chainlink-common/pkg/types/core"
+
 	feeds "github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 
 	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -37,6 +39,10 @@ import (
 
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	store "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+
+	triggers "github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
+
 	txmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -237,6 +243,26 @@ func (_m *Application) GetAuditLogger() audit.AuditLogger {
 	return r0
 }
 
+// GetCapabilitiesRegistry provides a mock function with given fields:
+func (_m *Application) GetCapabilitiesRegistry() core.CapabilitiesRegistry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCapabilitiesRegistry")
+	}
+
+	var r0 core.CapabilitiesRegistry
+	if rf, ok := ret.Get(0).(func() core.CapabilitiesRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.CapabilitiesRegistry)
+		}
+	}
+
+	return r0
+}
+
 // GetConfig provides a mock function with given fields:
 func (_m *Application) GetConfig() chainlink.GeneralConfig {
 	ret := _m.Called()
@@ -455,6 +481,26 @@ func (_m *Application) GetWebAuthnConfiguration() sessions.WebAuthnConfiguration
 	return r0
 }
 
+// HTTPTrigger provides a mock function with given fields:
+func (_m *Application) HTTPTrigger() *triggers.HTTPTrigger {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPTrigger")
+	}
+
+	var r0 *triggers.HTTPTrigger
+	if rf, ok := ret.Get(0).(func() *triggers.HTTPTrigger); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*triggers.HTTPTrigger)
+		}
+	}
+
+	return r0
+}
+
 // ID provides a mock function with given fields:
 func (_m *Application) ID() uuid.UUID {
 	ret := _m.Called()
@@ -553,6 +599,36 @@ func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBro
 	return r0
 }
 
+// ReplayJobRunV2 provides a mock function with given fields: ctx, run
+func (_m *Application) ReplayJobRunV2(ctx context.Context, run pipeline.Run) (*pipeline.Run, error) {
+	ret := _m.Called(ctx, run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayJobRunV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Run) (*pipeline.Run, error)); ok {
+		return rf(ctx, run)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Run) *pipeline.Run); ok {
+		r0 = rf(ctx, run)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Run) error); ok {
+		r1 = rf(ctx, run)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -726,6 +802,26 @@ func (_m *Application) WakeSessionReaper() {
 	_m.Called()
 }
 
+// WorkflowORM provides a mock function with given fields:
+func (_m *Application) WorkflowORM() store.Store {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowORM")
+	}
+
+	var r0 store.Store
+	if rf, ok := ret.Get(0).(func() store.Store); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.Store)
+		}
+	}
+
+	return r0
+}
+
 // NewApplication creates a new instance of Application. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewApplication(t interface {
@@ -49,6 +49,7 @@ const (
 	KeyDeleted  EventID = "KEY_DELETED"
 
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthTransactionCancelled  EventID = "ETH_TRANSACTION_CANCELLED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
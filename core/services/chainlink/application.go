@@ -32,6 +32,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	evmutils "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
@@ -92,6 +93,7 @@ type Application interface {
 	GetRelayers() RelayerChainInteroperators
 	GetLoopRegistry() *plugins.LoopRegistry
 	GetLoopRegistrarConfig() plugins.RegistrarConfig
+	GetCapabilitiesRegistry() coretypes.CapabilitiesRegistry
 
 	// V2 Jobs (TOML specified)
 	JobSpawner() job.Spawner
@@ -102,10 +104,14 @@ type Application interface {
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
+	WorkflowORM() workflowstore.Store
+	HTTPTrigger() *triggers.HTTPTrigger
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// ReplayJobRunV2 re-executes a finished run with its original inputs and recorded network responses.
+	ReplayJobRunV2(ctx context.Context, run pipeline.Run) (*pipeline.Run, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -140,6 +146,9 @@ type ChainlinkApplication struct {
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
 	txmStorageService        txmgr.EvmTxStore
+	workflowORM              workflowstore.Store
+	httpTrigger              *triggers.HTTPTrigger
+	capabilitiesRegistry     coretypes.CapabilitiesRegistry
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
 	Config                   GeneralConfig
@@ -214,10 +223,21 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 
 		// NOTE: RegistrySyncer will depend on a Relayer when fully implemented
 		dispatcher := remote.NewDispatcher(externalPeerWrapper, signer, opts.CapabilitiesRegistry, globalLogger)
-		registrySyncer := capabilities.NewRegistrySyncer(externalPeerWrapper, opts.CapabilitiesRegistry, dispatcher, globalLogger)
+		registrySyncer := capabilities.NewRegistrySyncer(externalPeerWrapper, opts.CapabilitiesRegistry, dispatcher, cfg.Capabilities().RemoteCapabilities(), globalLogger)
 		srvcs = append(srvcs, dispatcher, registrySyncer)
 	}
 
+	// Built-in trigger capabilities, available to workflows without deploying any plugins.
+	cronTrigger := triggers.NewCronTrigger(clockwork.NewRealClock(), globalLogger)
+	httpTrigger := triggers.NewHTTPTrigger(clockwork.NewRealClock(), globalLogger)
+	if err := opts.CapabilitiesRegistry.Add(context.TODO(), cronTrigger); err != nil {
+		return nil, fmt.Errorf("failed to add cron trigger capability: %w", err)
+	}
+	if err := opts.CapabilitiesRegistry.Add(context.TODO(), httpTrigger); err != nil {
+		return nil, fmt.Errorf("failed to add http trigger capability: %w", err)
+	}
+	srvcs = append(srvcs, cronTrigger)
+
 	// LOOPs can be created as options, in the  case of LOOP relayers, or
 	// as OCR2 job implementations, in the case of Median today.
 	// We will have a non-nil registry here in LOOP relayers are being used, otherwise
@@ -335,6 +355,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	}
 
 	srvcs = append(srvcs, pipelineORM)
+	srvcs = append(srvcs, workflows.NewReaper(workflowORM, cfg.Capabilities().Workflows(), clockwork.NewRealClock(), globalLogger))
 
 	var (
 		delegates = map[job.Type]job.Delegate{
@@ -548,6 +569,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		jobSpawner:               jobSpawner,
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
+		workflowORM:              workflowORM,
+		httpTrigger:              httpTrigger,
+		capabilitiesRegistry:     opts.CapabilitiesRegistry,
 		bridgeORM:                bridgeORM,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
@@ -640,6 +664,10 @@ func (app *ChainlinkApplication) GetLoopRegistrarConfig() plugins.RegistrarConfi
 	return app.loopRegistrarConfig
 }
 
+func (app *ChainlinkApplication) GetCapabilitiesRegistry() coretypes.CapabilitiesRegistry {
+	return app.capabilitiesRegistry
+}
+
 // Stop allows the application to exit by halting schedules, closing
 // logs, and closing the DB connection.
 func (app *ChainlinkApplication) Stop() error {
@@ -743,6 +771,14 @@ func (app *ChainlinkApplication) PipelineORM() pipeline.ORM {
 	return app.pipelineORM
 }
 
+func (app *ChainlinkApplication) WorkflowORM() workflowstore.Store {
+	return app.workflowORM
+}
+
+func (app *ChainlinkApplication) HTTPTrigger() *triggers.HTTPTrigger {
+	return app.httpTrigger
+}
+
 func (app *ChainlinkApplication) TxmStorageService() txmgr.EvmTxStore {
 	return app.txmStorageService
 }
@@ -855,6 +891,10 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(ctx, taskID, result.Value, result.Error)
 }
 
+func (app *ChainlinkApplication) ReplayJobRunV2(ctx context.Context, run pipeline.Run) (*pipeline.Run, error) {
+	return app.pipelineRunner.ReplayRun(ctx, run, app.logger)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
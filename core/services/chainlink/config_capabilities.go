@@ -1,6 +1,11 @@
 package chainlink
 
 import (
+	"strings"
+	"time"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 )
@@ -14,3 +19,41 @@ type capabilitiesConfig struct {
 func (c *capabilitiesConfig) Peering() config.P2P {
 	return &p2p{c: c.c.Peering}
 }
+
+func (c *capabilitiesConfig) Workflows() config.CapabilitiesWorkflows {
+	return &capabilitiesWorkflows{c: c.c.Workflows}
+}
+
+func (c *capabilitiesConfig) RemoteCapabilities() []commoncap.CapabilityInfo {
+	infos := make([]commoncap.CapabilityInfo, 0, len(c.c.RemoteCapabilities))
+	for _, r := range c.c.RemoteCapabilities {
+		capabilityType, _ := r.CapabilityType()
+		// IDs are versioned as {name}@{version}
+		_, version, _ := strings.Cut(*r.ID, "@")
+		infos = append(infos, commoncap.CapabilityInfo{
+			ID:             *r.ID,
+			CapabilityType: capabilityType,
+			Description:    "Remote " + *r.Type,
+			Version:        version,
+		})
+	}
+	return infos
+}
+
+var _ config.CapabilitiesWorkflows = (*capabilitiesWorkflows)(nil)
+
+type capabilitiesWorkflows struct {
+	c toml.CapabilitiesWorkflows
+}
+
+func (w *capabilitiesWorkflows) ReaperInterval() time.Duration {
+	return w.c.ReaperInterval.Duration()
+}
+
+func (w *capabilitiesWorkflows) ReaperThreshold() time.Duration {
+	return w.c.ReaperThreshold.Duration()
+}
+
+func (w *capabilitiesWorkflows) ReaperMaxExecutions() uint32 {
+	return *w.c.ReaperMaxExecutions
+}
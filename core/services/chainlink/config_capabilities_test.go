@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/libocr/commontypes"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 )
 
 func TestCapabilitiesConfig(t *testing.T) {
@@ -43,4 +45,16 @@ func TestCapabilitiesConfig(t *testing.T) {
 	assert.Equal(t, time.Minute, v2.DeltaDial().Duration())
 	assert.Equal(t, 2*time.Second, v2.DeltaReconcile().Duration())
 	assert.Equal(t, []string{"foo", "bar"}, v2.ListenAddresses())
+
+	wf := cfg.Capabilities().Workflows()
+	assert.Equal(t, 2*time.Hour, wf.ReaperInterval())
+	assert.Equal(t, 72*time.Hour, wf.ReaperThreshold())
+	assert.Equal(t, uint32(500), wf.ReaperMaxExecutions())
+
+	assert.Equal(t, []commoncap.CapabilityInfo{{
+		ID:             "offchain_reporting@1.0.0",
+		CapabilityType: commoncap.CapabilityTypeConsensus,
+		Description:    "Remote consensus",
+		Version:        "1.0.0",
+	}}, cfg.Capabilities().RemoteCapabilities())
 }
@@ -1,6 +1,7 @@
 package chainlink
 
 import (
+	"strings"
 	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
@@ -49,3 +50,29 @@ func (j *jobPipelineConfig) ExternalInitiatorsEnabled() bool {
 func (j *jobPipelineConfig) VerboseLogging() bool {
 	return *j.c.VerboseLogging
 }
+
+func (j *jobPipelineConfig) HTTPHostLimits() map[string]config.JobPipelineRequestLimit {
+	limits := make(map[string]config.JobPipelineRequestLimit, len(j.c.HTTPRequest.HostLimits))
+	for _, l := range j.c.HTTPRequest.HostLimits {
+		limits[strings.ToLower(*l.Host)] = newJobPipelineRequestLimit(l.MaxConcurrent, l.MaxRequestsPerSecond)
+	}
+	return limits
+}
+
+func (j *jobPipelineConfig) HTTPBridgeLimits() map[string]config.JobPipelineRequestLimit {
+	limits := make(map[string]config.JobPipelineRequestLimit, len(j.c.HTTPRequest.BridgeLimits))
+	for _, l := range j.c.HTTPRequest.BridgeLimits {
+		limits[strings.ToLower(*l.Bridge)] = newJobPipelineRequestLimit(l.MaxConcurrent, l.MaxRequestsPerSecond)
+	}
+	return limits
+}
+
+func newJobPipelineRequestLimit(maxConcurrent *uint32, maxRequestsPerSecond *float64) (l config.JobPipelineRequestLimit) {
+	if maxConcurrent != nil {
+		l.MaxConcurrent = *maxConcurrent
+	}
+	if maxRequestsPerSecond != nil {
+		l.MaxRequestsPerSecond = *maxRequestsPerSecond
+	}
+	return l
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -30,4 +31,10 @@ func TestJobPipelineConfigTest(t *testing.T) {
 	assert.Equal(t, 168*time.Hour, jp.ReaperThreshold())
 	assert.Equal(t, uint64(10), jp.ResultWriteQueueDepth())
 	assert.True(t, jp.ExternalInitiatorsEnabled())
+	assert.Equal(t, map[string]config.JobPipelineRequestLimit{
+		"api.example.com": {MaxConcurrent: 10, MaxRequestsPerSecond: 2.5},
+	}, jp.HTTPHostLimits())
+	assert.Equal(t, map[string]config.JobPipelineRequestLimit{
+		"coinmarketcap": {MaxConcurrent: 5, MaxRequestsPerSecond: 1},
+	}, jp.HTTPBridgeLimits())
 }
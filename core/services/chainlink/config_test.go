@@ -375,6 +375,16 @@ func TestConfig_Marshal(t *testing.T) {
 		HTTPRequest: toml.JobPipelineHTTPRequest{
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: commoncfg.MustNewDuration(time.Minute),
+			HostLimits: []toml.JobPipelineHostLimit{{
+				Host:                 ptr("api.example.com"),
+				MaxConcurrent:        ptr[uint32](10),
+				MaxRequestsPerSecond: ptr(2.5),
+			}},
+			BridgeLimits: []toml.JobPipelineBridgeLimit{{
+				Bridge:               ptr("coinmarketcap"),
+				MaxConcurrent:        ptr[uint32](5),
+				MaxRequestsPerSecond: ptr(1.0),
+			}},
 		},
 	}
 	full.FluxMonitor = toml.FluxMonitor{
@@ -444,6 +454,15 @@ func TestConfig_Marshal(t *testing.T) {
 				ListenAddresses: &[]string{"foo", "bar"},
 			},
 		},
+		Workflows: toml.CapabilitiesWorkflows{
+			ReaperInterval:      commoncfg.MustNewDuration(2 * time.Hour),
+			ReaperThreshold:     commoncfg.MustNewDuration(72 * time.Hour),
+			ReaperMaxExecutions: ptr[uint32](500),
+		},
+		RemoteCapabilities: []toml.CapabilitiesRemoteCapability{{
+			ID:   ptr("offchain_reporting@1.0.0"),
+			Type: ptr("consensus"),
+		}},
 	}
 	full.Keeper = toml.Keeper{
 		DefaultTransactionQueueDepth: ptr[uint32](17),
@@ -567,6 +586,11 @@ func TestConfig_Marshal(t *testing.T) {
 					ReaperThreshold:      &minute,
 					ResendAfterThreshold: &hour,
 					ForwardersEnabled:    ptr(true),
+					AutoPurge: evmcfg.AutoPurgeConfig{
+						Enabled:     ptr(true),
+						Threshold:   ptr[uint32](50),
+						MinAttempts: ptr[uint32](5),
+					},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -874,6 +898,16 @@ VerboseLogging = false
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+
+[[JobPipeline.HTTPRequest.HostLimits]]
+Host = 'api.example.com'
+MaxConcurrent = 10
+MaxRequestsPerSecond = 2.5
+
+[[JobPipeline.HTTPRequest.BridgeLimits]]
+Bridge = 'coinmarketcap'
+MaxConcurrent = 5
+MaxRequestsPerSecond = 1.0
 `},
 		{"OCR", Config{Core: toml.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -988,6 +1022,11 @@ ReaperInterval = '1m0s'
 ReaperThreshold = '1m0s'
 ResendAfterThreshold = '1h0m0s'
 
+[EVM.Transactions.AutoPurge]
+Enabled = true
+Threshold = 50
+MinAttempts = 5
+
 [EVM.BalanceMonitor]
 Enabled = true
 
@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // Cron runs a cron jobSpec from a CronSpec
@@ -86,6 +87,17 @@ func (cr *Cron) runPipeline() {
 	}
 }
 
+// scheduleParser parses the 6 field (with seconds) cron syntax used by cron jobs.
+var scheduleParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ParseSchedule validates and parses a cron schedule, using the same syntax as cron jobs.
+func ParseSchedule(schedule string) (cron.Schedule, error) {
+	if err := utils.ValidateCronSchedule(schedule); err != nil {
+		return nil, err
+	}
+	return scheduleParser.Parse(schedule)
+}
+
 func cronRunner() *cron.Cron {
-	return cron.New(cron.WithSeconds())
+	return cron.New(cron.WithParser(scheduleParser))
 }
@@ -2,6 +2,7 @@ package cron_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -75,3 +76,21 @@ func TestCronV2Schedule(t *testing.T) {
 
 	awaiter.AwaitOrFail(t)
 }
+
+func TestParseSchedule(t *testing.T) {
+	t.Parallel()
+
+	s, err := cron.ParseSchedule("CRON_TZ=UTC 0 */5 * * * *")
+	require.NoError(t, err)
+	from := time.Date(2024, 5, 1, 12, 1, 30, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 5, 1, 12, 5, 0, 0, time.UTC), s.Next(from))
+
+	_, err = cron.ParseSchedule("@every 10s")
+	require.NoError(t, err)
+
+	_, err = cron.ParseSchedule("0 */5 * * * *")
+	assert.ErrorContains(t, err, "must specify a time zone")
+
+	_, err = cron.ParseSchedule("CRON_TZ=UTC not a schedule")
+	assert.Error(t, err)
+}
@@ -19,6 +19,7 @@ import (
 	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	cnull "github.com/smartcontractkit/chainlink/v2/core/null"
 )
@@ -67,6 +68,8 @@ type (
 		ReaperInterval() time.Duration
 		ReaperThreshold() time.Duration
 		VerboseLogging() bool
+		HTTPHostLimits() map[string]coreconfig.JobPipelineRequestLimit
+		HTTPBridgeLimits() map[string]coreconfig.JobPipelineRequestLimit
 	}
 
 	BridgeConfig interface {
@@ -75,6 +78,24 @@ type (
 	}
 )
 
+// branchingTask is implemented by tasks which route execution to some of their outputs.
+// Outputs on branches which aren't taken are skipped, along with all of the tasks depending on them,
+// up to the first joinTask.
+type branchingTask interface {
+	Task
+	// branchTargets returns the DOT IDs of all outputs which are on a branch.
+	branchTargets() ([]string, error)
+	// skippedOutputs returns the DOT IDs of the outputs on the branches which weren't taken,
+	// given the task's successful result.
+	skippedOutputs(result Result) map[string]bool
+}
+
+// joinTask is implemented by tasks which merge branches. They are only skipped if all of their inputs are.
+type joinTask interface {
+	Task
+	isJoin()
+}
+
 // Wraps the input Task for the given dependent task along with a bool variable PropagateResult,
 // which Indicates whether result of InputTask should be propagated to its dependent task.
 // If the edge between these tasks was an implicit edge, then results are not propagated. This is because
@@ -132,6 +153,8 @@ func isRetryableHTTPError(statusCode int, err error) bool {
 type Result struct {
 	Value interface{}
 	Error error
+	// Skipped is set if the task wasn't run because it's on a branch which wasn't taken
+	Skipped bool
 }
 
 // OutputDB dumps a single result output for a pipeline_run or pipeline_task_run
@@ -286,6 +309,7 @@ const (
 	TaskTypeBase64Encode     TaskType = "base64encode"
 	TaskTypeBridge           TaskType = "bridge"
 	TaskTypeCBORParse        TaskType = "cborparse"
+	TaskTypeCoalesce         TaskType = "coalesce"
 	TaskTypeConditional      TaskType = "conditional"
 	TaskTypeDivide           TaskType = "divide"
 	TaskTypeETHABIDecode     TaskType = "ethabidecode"
@@ -293,26 +317,38 @@ const (
 	TaskTypeETHABIEncode     TaskType = "ethabiencode"
 	TaskTypeETHABIEncode2    TaskType = "ethabiencode2"
 	TaskTypeETHCall          TaskType = "ethcall"
+	TaskTypeETHGetBlock      TaskType = "ethgetblock"
+	TaskTypeETHGetLogs       TaskType = "ethgetlogs"
 	TaskTypeETHTx            TaskType = "ethtx"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
+	TaskTypeExpr             TaskType = "expr"
 	TaskTypeHTTP             TaskType = "http"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
+	TaskTypeIf               TaskType = "if"
 	TaskTypeJSONParse        TaskType = "jsonparse"
+	TaskTypeJSONQuery        TaskType = "jsonquery"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
 	TaskTypeLowercase        TaskType = "lowercase"
+	TaskTypeMax              TaskType = "max"
 	TaskTypeMean             TaskType = "mean"
 	TaskTypeMedian           TaskType = "median"
 	TaskTypeMerge            TaskType = "merge"
+	TaskTypeMin              TaskType = "min"
 	TaskTypeMode             TaskType = "mode"
 	TaskTypeMultiply         TaskType = "multiply"
+	TaskTypePercentile       TaskType = "percentile"
+	TaskTypeStddev           TaskType = "stddev"
 	TaskTypeSum              TaskType = "sum"
+	TaskTypeSwitch           TaskType = "switch"
+	TaskTypeTrimmedMean      TaskType = "trimmedmean"
 	TaskTypeUppercase        TaskType = "uppercase"
 	TaskTypeVRF              TaskType = "vrf"
 	TaskTypeVRFV2            TaskType = "vrfv2"
 	TaskTypeVRFV2Plus        TaskType = "vrfv2plus"
+	TaskTypeWeightedMedian   TaskType = "weightedmedian"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -353,12 +389,26 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &MedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMode:
 		task = &ModeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMin:
+		task = &MinTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMax:
+		task = &MaxTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeTrimmedMean:
+		task = &TrimmedMeanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeWeightedMedian:
+		task = &WeightedMedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeStddev:
+		task = &StddevTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypePercentile:
+		task = &PercentileTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeSum:
 		task = &SumTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeAny:
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJSONQuery:
+		task = &JSONQueryTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
@@ -375,6 +425,10 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &EstimateGasLimitTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHCall:
 		task = &ETHCallTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeETHGetBlock:
+		task = &ETHGetBlockTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeETHGetLogs:
+		task = &ETHGetLogsTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHTx:
 		task = &ETHTxTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHABIEncode:
@@ -403,6 +457,14 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &UppercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeConditional:
 		task = &ConditionalTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeExpr:
+		task = &ExprTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeIf:
+		task = &IfTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeSwitch:
+		task = &SwitchTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeCoalesce:
+		task = &CoalesceTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeHexDecode:
 		task = &HexDecodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeHexEncode:
@@ -333,3 +333,17 @@ func convertToETHABIInteger(val interface{}, abiType abi.Type) (interface{}, err
 
 	return converted.Interface(), nil
 }
+
+// parseBlockNumber parses a block number given in decimal or as hex with a 0x prefix. An empty string or
+// "latest" returns nil, which stands for the latest block.
+func parseBlockNumber(s string) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.ToLower(s) == "latest" {
+		return nil, nil
+	}
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok || n.Sign() < 0 {
+		return nil, errors.Wrapf(ErrBadInput, "invalid block number %q", s)
+	}
+	return n, nil
+}
@@ -2,14 +2,22 @@ package pipeline
 
 import (
 	"bytes"
+	"container/list"
 	"context"
 	"encoding/json"
 	"io"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
 
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
 )
@@ -104,3 +112,239 @@ func httpRequestCtx(ctx context.Context, t Task, cfg Config) (requestCtx context
 	}
 	return
 }
+
+// maxHTTPCacheEntries bounds the memory used by httpResponseCache. The least recently used entry is evicted
+// when it's full.
+const maxHTTPCacheEntries = 10_000
+
+type httpCacheResult string
+
+const (
+	httpCacheMiss      httpCacheResult = "miss"
+	httpCacheHit       httpCacheResult = "hit"
+	httpCacheCoalesced httpCacheResult = "coalesced"
+)
+
+type httpResponse struct {
+	body       []byte
+	statusCode int
+	headers    http.Header
+	elapsed    time.Duration
+}
+
+type httpCacheEntry struct {
+	key       string
+	body      []byte
+	fetchedAt time.Time
+}
+
+// httpResponseCache is an in-memory cache of successful HTTP responses, shared by the HTTP tasks of all
+// jobs. Concurrent identical requests are coalesced into one. Since jobs sending the same request may
+// have different TTLs, each of them is only served responses fetched within its own TTL.
+type httpResponseCache struct {
+	mu         sync.Mutex
+	entries    map[string]*list.Element
+	lru        *list.List // of *httpCacheEntry, most recently used first
+	maxEntries int
+	group      singleflight.Group
+}
+
+func newHTTPResponseCache() *httpResponseCache {
+	return &httpResponseCache{entries: make(map[string]*list.Element), lru: list.New(), maxEntries: maxHTTPCacheEntries}
+}
+
+// httpCacheKey identifies a request. Headers and the network access of the client are included, since
+// they may change the response.
+func httpCacheKey(method StringParam, url URLParam, requestData MapParam, reqHeaders []string, allowUnrestrictedNetworkAccess BoolParam) (string, error) {
+	key, err := json.Marshal([]interface{}{method, url.String(), requestData, reqHeaders, allowUnrestrictedNetworkAccess})
+	return string(key), err
+}
+
+// get returns the cached response for key, if it was fetched within ttl.
+func (c *httpResponseCache) get(key string, ttl time.Duration) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*httpCacheEntry)
+	if time.Since(entry.fetchedAt) > ttl {
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return entry.body, true
+}
+
+// put caches the response for key, evicting the least recently used entry if the cache is full.
+func (c *httpResponseCache) put(key string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*httpCacheEntry)
+		entry.body, entry.fetchedAt = body, time.Now()
+		c.lru.MoveToFront(elem)
+		return
+	}
+	if c.lru.Len() >= c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*httpCacheEntry).key)
+	}
+	c.entries[key] = c.lru.PushFront(&httpCacheEntry{key: key, body: body, fetchedAt: time.Now()})
+}
+
+// fetch returns the cached response for key if there is one. Otherwise it sends the request, sharing it
+// with concurrent callers for the same key, and caches the response if it's successful.
+func (c *httpResponseCache) fetch(ctx context.Context, key string, ttl time.Duration, send func(context.Context) (httpResponse, error)) (httpResponse, httpCacheResult, error) {
+	if body, ok := c.get(key, ttl); ok {
+		return httpResponse{body: body}, httpCacheHit, nil
+	}
+
+	ch := c.group.DoChan(key, func() (interface{}, error) {
+		// the request is shared, so it mustn't be cancelled along with the caller which happened to send it
+		requestCtx, cancel := context.WithoutCancel(ctx), context.CancelFunc(func() {})
+		if deadline, ok := ctx.Deadline(); ok {
+			requestCtx, cancel = context.WithDeadline(requestCtx, deadline)
+		}
+		defer cancel()
+
+		response, err := send(requestCtx)
+		if err == nil {
+			c.put(key, response.body)
+		}
+		return response, err
+	})
+
+	select {
+	case <-ctx.Done():
+		return httpResponse{}, httpCacheMiss, errors.New("http request timed out or interrupted")
+	case res := <-ch:
+		result := httpCacheMiss
+		if res.Shared {
+			result = httpCacheCoalesced
+		}
+		return res.Val.(httpResponse), result, res.Err
+	}
+}
+
+var (
+	promHTTPQueuedRequests = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_task_http_queued_requests",
+		Help: "The number of requests of HTTP and bridge tasks waiting for the concurrency or rate limit of a host or bridge",
+	},
+		[]string{"limit", "name"},
+	)
+	promHTTPQueueTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_task_http_queue_time_seconds",
+		Help:    "The time requests of HTTP and bridge tasks waited for the concurrency or rate limit of a host or bridge",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	},
+		[]string{"limit", "name"},
+	)
+)
+
+// httpRequestLimiter enforces the concurrency and rate limits of the requests of HTTP and bridge tasks to
+// each host and bridge, across all runs. A nil limiter doesn't limit requests.
+type httpRequestLimiter struct {
+	hosts   map[string]*requestLimit
+	bridges map[string]*requestLimit
+}
+
+type requestLimit struct {
+	kind, name string
+	// slots holds a token for each request in flight, or is nil if concurrency is unlimited
+	slots chan struct{}
+	// rate is nil if the rate is unlimited
+	rate *rate.Limiter
+}
+
+func newHTTPRequestLimiter(hosts, bridges map[string]coreconfig.JobPipelineRequestLimit) *httpRequestLimiter {
+	l := &httpRequestLimiter{
+		hosts:   make(map[string]*requestLimit, len(hosts)),
+		bridges: make(map[string]*requestLimit, len(bridges)),
+	}
+	for host, limit := range hosts {
+		l.hosts[strings.ToLower(host)] = newRequestLimit("host", host, limit)
+	}
+	for bridge, limit := range bridges {
+		l.bridges[strings.ToLower(bridge)] = newRequestLimit("bridge", bridge, limit)
+	}
+	return l
+}
+
+func newRequestLimit(kind, name string, limit coreconfig.JobPipelineRequestLimit) *requestLimit {
+	rl := &requestLimit{kind: kind, name: name}
+	if limit.MaxConcurrent > 0 {
+		rl.slots = make(chan struct{}, limit.MaxConcurrent)
+	}
+	if limit.MaxRequestsPerSecond > 0 {
+		// a burst of one spreads requests out evenly, so that no window of a second exceeds the rate
+		rl.rate = rate.NewLimiter(rate.Limit(limit.MaxRequestsPerSecond), 1)
+	}
+	return rl
+}
+
+// acquire waits until a request may be sent to the host, and to the bridge if it's set. Hosts and bridge
+// names are case-insensitive. The returned func must be called once the request has completed.
+func (l *httpRequestLimiter) acquire(ctx context.Context, host, bridge string) (release func(), err error) {
+	release = func() {}
+	if l == nil {
+		return release, nil
+	}
+
+	var limits []*requestLimit
+	if rl, ok := l.bridges[strings.ToLower(bridge)]; ok && bridge != "" {
+		limits = append(limits, rl)
+	}
+	if rl, ok := l.hosts[strings.ToLower(host)]; ok {
+		limits = append(limits, rl)
+	}
+
+	var releases []func()
+	release = func() {
+		for i := len(releases) - 1; i >= 0; i-- {
+			releases[i]()
+		}
+	}
+	for _, rl := range limits {
+		r, err := rl.wait(ctx)
+		if err != nil {
+			release()
+			return func() {}, err
+		}
+		releases = append(releases, r)
+	}
+	return release, nil
+}
+
+func (rl *requestLimit) wait(ctx context.Context) (release func(), err error) {
+	release = func() {}
+	if rl.slots == nil && rl.rate == nil {
+		return release, nil
+	}
+
+	queued := promHTTPQueuedRequests.WithLabelValues(rl.kind, rl.name)
+	queued.Inc()
+	defer queued.Dec()
+	start := time.Now()
+	defer func() {
+		promHTTPQueueTime.WithLabelValues(rl.kind, rl.name).Observe(time.Since(start).Seconds())
+	}()
+
+	if rl.slots != nil {
+		select {
+		case rl.slots <- struct{}{}:
+			release = func() { <-rl.slots }
+		case <-ctx.Done():
+			return func() {}, errors.Wrapf(ctx.Err(), "waiting for the concurrency limit of %s %s", rl.kind, rl.name)
+		}
+	}
+	if rl.rate != nil {
+		if err = rl.rate.Wait(ctx); err != nil {
+			release()
+			return func() {}, errors.Wrapf(err, "waiting for the rate limit of %s %s", rl.kind, rl.name)
+		}
+	}
+	return release, nil
+}
@@ -0,0 +1,72 @@
+package pipeline
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+)
+
+// resolveAggregationValues resolves the values of an aggregation task, which may contain errors. Like
+// MedianTask, it fails if more of the values are errors than allowedFaults permits, and allowedFaults
+// defaults to one less than the number of values.
+func resolveAggregationValues(taskType TaskType, values, allowedFaults string, vars Vars, inputs []Result) (SliceParam, error) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		valuesAndErrs      SliceParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(allowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(values, vars), JSONWithVarExprs(values, vars, true), Inputs(inputs))), "values"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := len(valuesAndErrs) - 1
+	if n, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowed = int(n)
+	}
+
+	if _, faults := valuesAndErrs.FilterErrors(); faults > allowed {
+		return nil, errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to %s task > number allowed faults %v", faults, taskType, allowed)
+	}
+	return valuesAndErrs, nil
+}
+
+// resolveAggregationDecimals resolves the values of an aggregation task as in resolveAggregationValues,
+// and returns the ones which aren't errors sorted in ascending order.
+func resolveAggregationDecimals(taskType TaskType, values, allowedFaults string, vars Vars, inputs []Result) ([]decimal.Decimal, error) {
+	valuesAndErrs, err := resolveAggregationValues(taskType, values, allowedFaults, vars, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	nonErrors, _ := valuesAndErrs.FilterErrors()
+	if len(nonErrors) == 0 {
+		return nil, errors.Wrap(ErrWrongInputCardinality, "values")
+	}
+
+	var decimalValues DecimalSliceParam
+	if err = decimalValues.UnmarshalPipelineParam(nonErrors); err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "values: %v", err)
+	}
+
+	sortDecimals(decimalValues)
+	return decimalValues, nil
+}
+
+func sortDecimals(values []decimal.Decimal) {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].LessThan(values[j])
+	})
+}
+
+// sqrtDecimal returns the square root of a non-negative decimal, rounded to precision decimal places.
+func sqrtDecimal(d decimal.Decimal, precision int32) decimal.Decimal {
+	f, _ := new(big.Float).SetPrec(256).SetString(d.String())
+	root := new(big.Float).SetPrec(256).Sqrt(f)
+	return decimal.RequireFromString(root.Text('f', int(precision)+1)).Round(precision)
+}
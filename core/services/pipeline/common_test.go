@@ -137,11 +137,18 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeBridge, &pipeline.BridgeTask{}},
 		{pipeline.TaskTypeMean, &pipeline.MeanTask{}},
 		{pipeline.TaskTypeMedian, &pipeline.MedianTask{}},
+		{pipeline.TaskTypeMin, &pipeline.MinTask{}},
+		{pipeline.TaskTypeMax, &pipeline.MaxTask{}},
+		{pipeline.TaskTypeTrimmedMean, &pipeline.TrimmedMeanTask{}},
+		{pipeline.TaskTypeWeightedMedian, &pipeline.WeightedMedianTask{}},
+		{pipeline.TaskTypeStddev, &pipeline.StddevTask{}},
+		{pipeline.TaskTypePercentile, &pipeline.PercentileTask{}},
 		{pipeline.TaskTypeMode, &pipeline.ModeTask{}},
 		{pipeline.TaskTypeSum, &pipeline.SumTask{}},
 		{pipeline.TaskTypeMultiply, &pipeline.MultiplyTask{}},
 		{pipeline.TaskTypeDivide, &pipeline.DivideTask{}},
 		{pipeline.TaskTypeJSONParse, &pipeline.JSONParseTask{}},
+		{pipeline.TaskTypeJSONQuery, &pipeline.JSONQueryTask{}},
 		{pipeline.TaskTypeCBORParse, &pipeline.CBORParseTask{}},
 		{pipeline.TaskTypeAny, &pipeline.AnyTask{}},
 		{pipeline.TaskTypeVRF, &pipeline.VRFTask{}},
@@ -149,6 +156,8 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeVRFV2Plus, &pipeline.VRFTaskV2Plus{}},
 		{pipeline.TaskTypeEstimateGasLimit, &pipeline.EstimateGasLimitTask{}},
 		{pipeline.TaskTypeETHCall, &pipeline.ETHCallTask{}},
+		{pipeline.TaskTypeETHGetBlock, &pipeline.ETHGetBlockTask{}},
+		{pipeline.TaskTypeETHGetLogs, &pipeline.ETHGetLogsTask{}},
 		{pipeline.TaskTypeETHTx, &pipeline.ETHTxTask{}},
 		{pipeline.TaskTypeETHABIEncode, &pipeline.ETHABIEncodeTask{}},
 		{pipeline.TaskTypeETHABIEncode2, &pipeline.ETHABIEncodeTask2{}},
@@ -158,6 +167,10 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeLowercase, &pipeline.LowercaseTask{}},
 		{pipeline.TaskTypeUppercase, &pipeline.UppercaseTask{}},
 		{pipeline.TaskTypeConditional, &pipeline.ConditionalTask{}},
+		{pipeline.TaskTypeExpr, &pipeline.ExprTask{}},
+		{pipeline.TaskTypeIf, &pipeline.IfTask{}},
+		{pipeline.TaskTypeSwitch, &pipeline.SwitchTask{}},
+		{pipeline.TaskTypeCoalesce, &pipeline.CoalesceTask{}},
 		{pipeline.TaskTypeHexDecode, &pipeline.HexDecodeTask{}},
 		{pipeline.TaskTypeBase64Decode, &pipeline.Base64DecodeTask{}},
 	}
@@ -271,5 +271,35 @@ func Parse(text string) (*Pipeline, error) {
 		ids[node.ID()] = id
 	}
 
+	for _, task := range p.Tasks {
+		if err := validateBranches(task); err != nil {
+			return nil, err
+		}
+	}
+
 	return p, nil
 }
+
+// validateBranches checks that every branch of a branching task leads to one of its outputs.
+func validateBranches(task Task) error {
+	b, ok := task.(branchingTask)
+	if !ok {
+		return nil
+	}
+
+	targets, err := b.branchTargets()
+	if err != nil {
+		return errors.Wrapf(err, "task %s", task.DotID())
+	}
+
+	outputs := map[string]bool{}
+	for _, output := range task.Outputs() {
+		outputs[output.DotID()] = true
+	}
+	for _, target := range targets {
+		if !outputs[target] {
+			return errors.Errorf("task %s: branch target %s is not an output of the task", task.DotID(), target)
+		}
+	}
+	return nil
+}
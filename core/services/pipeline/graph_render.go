@@ -0,0 +1,203 @@
+package pipeline
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+)
+
+// Colours of the tasks of rendered graphs, by status.
+var graphStatusColors = map[string]string{
+	"":          "#ffffff",
+	"completed": "#c8e6c9",
+	"errored":   "#ffcdd2",
+	"skipped":   "#eeeeee",
+	"running":   "#fff9c4",
+	"not run":   "#ffffff",
+}
+
+const (
+	graphNodeWidth   = 220
+	graphNodeHeight  = 76
+	graphColumnWidth = 250
+	graphRowHeight   = 120
+	graphMargin      = 20
+	graphLineHeight  = 16
+	// graphMaxErrorLength is the length of the longest error shown in a task of an SVG graph. Longer
+	// errors are truncated, and shown in full on hover.
+	graphMaxErrorLength = 32
+)
+
+// graphNode is a task of a rendered graph, annotated with its task run if a run is rendered.
+type graphNode struct {
+	task     Task
+	status   string
+	duration time.Duration
+	err      string
+}
+
+func (n graphNode) lines() []string {
+	lines := []string{n.task.DotID(), string(n.task.Type())}
+	if n.status != "" {
+		status := n.status
+		if n.duration > 0 {
+			status += " in " + n.duration.String()
+		}
+		lines = append(lines, status)
+	}
+	return lines
+}
+
+// graphNodes annotates the tasks of the pipeline with their task runs in the run, if it's non-nil.
+func graphNodes(p *Pipeline, run *Run) []graphNode {
+	nodes := make([]graphNode, len(p.Tasks))
+	for i, task := range p.Tasks {
+		nodes[i].task = task
+		if run == nil {
+			continue
+		}
+
+		taskRun := run.ByDotID(task.DotID())
+		switch {
+		case taskRun == nil:
+			nodes[i].status = "not run"
+		case taskRun.Skipped:
+			nodes[i].status = "skipped"
+		case taskRun.Error.Valid:
+			nodes[i].status = "errored"
+			nodes[i].err = taskRun.Error.String
+		case taskRun.FinishedAt.Valid:
+			nodes[i].status = "completed"
+		default:
+			nodes[i].status = "running"
+		}
+		if taskRun != nil && taskRun.FinishedAt.Valid && !taskRun.Skipped {
+			nodes[i].duration = taskRun.FinishedAt.Time.Sub(taskRun.CreatedAt).Round(time.Millisecond)
+		}
+	}
+	return nodes
+}
+
+// RenderDOT renders the pipeline as a DOT graph. If run is non-nil, each task is labelled and coloured with
+// the status, duration and error of its task run in the run. Edges which don't pass on results are dashed.
+func RenderDOT(p *Pipeline, run *Run) string {
+	var b strings.Builder
+	b.WriteString("digraph pipeline {\n")
+	b.WriteString("\tnode [shape=box style=\"rounded,filled\" fontname=\"Helvetica\"];\n")
+	for _, node := range graphNodes(p, run) {
+		lines := node.lines()
+		if node.err != "" {
+			lines = append(lines, node.err)
+		}
+		fmt.Fprintf(&b, "\t%s [label=%s fillcolor=%s];\n", dotQuote(node.task.DotID()), dotQuote(strings.Join(lines, "\n")), dotQuote(graphStatusColors[node.status]))
+	}
+	for _, task := range p.Tasks {
+		for _, input := range task.Inputs() {
+			style := ""
+			if !input.PropagateResult {
+				style = " [style=dashed]"
+			}
+			fmt.Fprintf(&b, "\t%s -> %s%s;\n", dotQuote(input.InputTask.DotID()), dotQuote(task.DotID()), style)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// RenderSVG renders the pipeline as an SVG image, annotated like RenderDOT. Tasks are laid out top to
+// bottom in rows, where each task is in the row after the last of its inputs.
+func RenderSVG(p *Pipeline, run *Run) string {
+	nodes := graphNodes(p, run)
+
+	// p.Tasks is sorted topologically, so the inputs of each task are laid out before it
+	rows := make(map[int]int, len(p.Tasks))
+	columns := make(map[int]int, len(p.Tasks))
+	var rowSizes []int
+	for _, task := range p.Tasks {
+		row := 0
+		for _, input := range task.Inputs() {
+			if r := rows[input.InputTask.ID()] + 1; r > row {
+				row = r
+			}
+		}
+		if row == len(rowSizes) {
+			rowSizes = append(rowSizes, 0)
+		}
+		rows[task.ID()] = row
+		columns[task.ID()] = rowSizes[row]
+		rowSizes[row]++
+	}
+
+	maxRowSize := 0
+	for _, size := range rowSizes {
+		if size > maxRowSize {
+			maxRowSize = size
+		}
+	}
+	width := 2*graphMargin + graphNodeWidth + (maxRowSize-1)*graphColumnWidth
+	if maxRowSize == 0 {
+		width = 2 * graphMargin
+	}
+	height := 2*graphMargin + graphNodeHeight + (len(rowSizes)-1)*graphRowHeight
+
+	// rows narrower than the widest are centred
+	position := func(task Task) (x, y int) {
+		row := rows[task.ID()]
+		x = graphMargin + (maxRowSize-rowSizes[row])*graphColumnWidth/2 + columns[task.ID()]*graphColumnWidth
+		y = graphMargin + row*graphRowHeight
+		return x, y
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="Helvetica, sans-serif" font-size="12">`+"\n", width, height, width, height)
+	b.WriteString(`<defs><marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" orient="auto"><path d="M 0 0 L 10 5 L 0 10 z" fill="#555555"/></marker></defs>` + "\n")
+
+	for _, task := range p.Tasks {
+		x2, y2 := position(task)
+		for _, input := range task.Inputs() {
+			x1, y1 := position(input.InputTask)
+			dash := ""
+			if !input.PropagateResult {
+				dash = ` stroke-dasharray="4 4"`
+			}
+			fmt.Fprintf(&b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#555555" marker-end="url(#arrow)"%s/>`+"\n",
+				x1+graphNodeWidth/2, y1+graphNodeHeight, x2+graphNodeWidth/2, y2, dash)
+		}
+	}
+
+	for _, node := range nodes {
+		x, y := position(node.task)
+		lines := node.lines()
+		title := strings.Join(lines, "\n")
+		if node.err != "" {
+			title += "\n" + node.err
+			errLine := node.err
+			if runes := []rune(errLine); len(runes) > graphMaxErrorLength {
+				errLine = string(runes[:graphMaxErrorLength-3]) + "..."
+			}
+			lines = append(lines, errLine)
+		}
+
+		fmt.Fprintf(&b, `<g id="task-%s"><title>%s</title>`, html.EscapeString(node.task.DotID()), html.EscapeString(title))
+		fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d" rx="6" fill="%s" stroke="#555555"/>`, x, y, graphNodeWidth, graphNodeHeight, graphStatusColors[node.status])
+		textY := y + (graphNodeHeight-len(lines)*graphLineHeight)/2 + graphLineHeight - 4
+		for i, line := range lines {
+			weight := ""
+			if i == 0 {
+				weight = ` font-weight="bold"`
+			}
+			fmt.Fprintf(&b, `<text x="%d" y="%d" text-anchor="middle"%s>%s</text>`, x+graphNodeWidth/2, textY+i*graphLineHeight, weight, html.EscapeString(line))
+		}
+		b.WriteString("</g>\n")
+	}
+	b.WriteString("</svg>\n")
+	return b.String()
+}
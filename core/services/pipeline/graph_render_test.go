@@ -0,0 +1,76 @@
+package pipeline_test
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const renderedPipeline = `
+ds1          [type=http url="https://example.com"];
+ds1_parse    [type=jsonparse path="data,result"];
+ds2          [type=http url="https://example.com"];
+ds2_parse    [type=jsonparse path="data,result"];
+answer       [type=median];
+ds1 -> ds1_parse -> answer;
+ds2 -> ds2_parse -> answer;
+`
+
+func TestRenderDOT(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(renderedPipeline)
+	require.NoError(t, err)
+
+	t.Run("spec", func(t *testing.T) {
+		dot := pipeline.RenderDOT(p, nil)
+		assert.Contains(t, dot, `"ds1" [label="ds1\nhttp" fillcolor="#ffffff"];`)
+		assert.Contains(t, dot, `"ds1" -> "ds1_parse";`)
+		assert.Contains(t, dot, `"ds2_parse" -> "answer";`)
+	})
+
+	t.Run("run", func(t *testing.T) {
+		dot := pipeline.RenderDOT(p, renderedRun())
+		assert.Contains(t, dot, `"ds1" [label="ds1\nhttp\ncompleted in 1.5s" fillcolor="#c8e6c9"];`)
+		assert.Contains(t, dot, `"ds2" [label="ds2\nhttp\nerrored in 2s\nconnection \"refused\"" fillcolor="#ffcdd2"];`)
+		assert.Contains(t, dot, `"ds2_parse" [label="ds2_parse\njsonparse\nskipped" fillcolor="#eeeeee"];`)
+		assert.Contains(t, dot, `"answer" [label="answer\nmedian\nrunning" fillcolor="#fff9c4"];`)
+		assert.Contains(t, dot, `"ds1_parse" [label="ds1_parse\njsonparse\nnot run" fillcolor="#ffffff"];`)
+	})
+}
+
+func TestRenderSVG(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(renderedPipeline)
+	require.NoError(t, err)
+
+	svg := pipeline.RenderSVG(p, renderedRun())
+	require.NoError(t, xml.Unmarshal([]byte(svg), new(interface{})), "must be valid XML")
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="510" height="356"`), svg)
+	assert.Equal(t, 4, strings.Count(svg, "<line "))
+	assert.Contains(t, svg, `<g id="task-ds2"><title>ds2
+http
+errored in 2s
+connection &#34;refused&#34;</title>`)
+	assert.Contains(t, svg, `fill="#ffcdd2"`)
+}
+
+func renderedRun() *pipeline.Run {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &pipeline.Run{
+		PipelineTaskRuns: []pipeline.TaskRun{
+			{DotID: "ds1", CreatedAt: start, FinishedAt: null.TimeFrom(start.Add(1500 * time.Millisecond))},
+			{DotID: "ds2", CreatedAt: start, FinishedAt: null.TimeFrom(start.Add(2 * time.Second)), Error: null.StringFrom(`connection "refused"`)},
+			{DotID: "ds2_parse", CreatedAt: start, FinishedAt: null.TimeFrom(start.Add(2 * time.Second)), Skipped: true},
+			{DotID: "answer", CreatedAt: start},
+		},
+	}
+}
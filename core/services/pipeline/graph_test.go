@@ -266,3 +266,26 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestParse_Branches(t *testing.T) {
+	for _, s := range []struct {
+		name     string
+		pipeline string
+		err      string
+	}{
+		{"if", `a [type=if then="b" else="c"]; b [type=memo]; c [type=memo]; a -> b; a -> c`, ""},
+		{"switch", `a [type=switch cases=<{"x": "b", "y": ["c"]}>]; b [type=memo]; c [type=memo]; a -> b; a -> c`, ""},
+		{"if target isn't an output", `a [type=if then="b" else="c"]; b [type=memo]; c [type=memo]; a -> b`, "task a: branch target c is not an output of the task"},
+		{"switch target isn't an output", `a [type=switch cases=<{"x": "b"}> default="c"]; b [type=memo]; c [type=memo]; a -> b; b -> c`, "task a: branch target c is not an output of the task"},
+		{"invalid cases", `a [type=switch cases="x"]; b [type=memo]; a -> b`, "task a: cases"},
+	} {
+		t.Run(s.name, func(t *testing.T) {
+			_, err := pipeline.Parse(s.pipeline)
+			if s.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, s.err)
+			}
+		})
+	}
+}
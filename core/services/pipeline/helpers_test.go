@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 )
 
 const (
@@ -50,6 +51,30 @@ func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, un
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+type HTTPResponseCache = httpResponseCache
+
+func NewHTTPResponseCache() *HTTPResponseCache {
+	return newHTTPResponseCache()
+}
+
+func (c *HTTPResponseCache) HelperSetMaxEntries(maxEntries int) {
+	c.maxEntries = maxEntries
+}
+
+func (t *HTTPTask) HelperSetCache(cache *HTTPResponseCache) {
+	t.cache = cache
+}
+
+type HTTPRequestLimiter = httpRequestLimiter
+
+func NewHTTPRequestLimiter(hosts, bridges map[string]coreconfig.JobPipelineRequestLimit) *HTTPRequestLimiter {
+	return newHTTPRequestLimiter(hosts, bridges)
+}
+
+func (t *HTTPTask) HelperSetLimiter(limiter *HTTPRequestLimiter) {
+	t.limiter = limiter
+}
+
 func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, config Config, specGasLimit *uint32, jobType string) {
 	t.legacyChains = legacyChains
 	t.config = config
@@ -57,6 +82,14 @@ func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainCo
 	t.jobType = jobType
 }
 
+func (t *ETHGetBlockTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer) {
+	t.legacyChains = legacyChains
+}
+
+func (t *ETHGetLogsTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer) {
+	t.legacyChains = legacyChains
+}
+
 func (t *ETHTxTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, keyStore ETHKeyStore, specGasLimit *uint32, jobType string) {
 	t.legacyChains = legacyChains
 	t.keyStore = keyStore
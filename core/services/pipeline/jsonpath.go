@@ -0,0 +1,777 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+var ErrWrongJSONPath = errors.New("wrong JSONPath format")
+
+// maxJSONPathInt bounds the indexes and steps of queries to the exact integer range of JSON (I-JSON). Larger
+// values select the same elements as this one for any list, and can't overflow when added to an index.
+const maxJSONPathInt = 1<<53 - 1
+
+// JSONPath is a JSONPath query parsed by ParseJSONPath.
+//
+// The supported syntax follows RFC 9535:
+//
+//	$.data[0].price             child members and array indexes
+//	$.data[*].price             wildcards
+//	$.data[-2:]                 array slices, [start:end:step]
+//	$.data[0,2]['a','b']        unions
+//	$..price                    recursive descent
+//	$.data[?(@.exchange != 'x' && @.price > 0)]
+//	                            filters, supporting ==, !=, <, <=, >, >=, &&, ||, ! and existence tests
+//
+// A query may end in one of the functions length(), min(), max(), sum(), avg() or median(), e.g.
+// `$.data[*].price.median()`, which are applied to the values matched by the rest of the query.
+type JSONPath struct {
+	segments []jsonPathSegment
+	function string
+}
+
+type jsonPathSegment struct {
+	recursive bool
+	selectors []jsonPathSelector
+}
+
+type jsonPathSelector struct {
+	kind  jsonPathSelectorKind
+	name  string
+	index int
+	// slice bounds, nil when omitted
+	start, end, step *int
+	filter           jsonPathExpr
+}
+
+type jsonPathSelectorKind int
+
+const (
+	selectName jsonPathSelectorKind = iota
+	selectIndex
+	selectWildcard
+	selectSlice
+	selectFilter
+)
+
+var jsonPathFunctions = map[string]bool{
+	"length": true,
+	"min":    true,
+	"max":    true,
+	"sum":    true,
+	"avg":    true,
+	"median": true,
+}
+
+// ParseJSONPath parses a JSONPath query.
+func ParseJSONPath(query string) (JSONPath, error) {
+	p := &jsonPathParser{input: query}
+	path, err := p.parseQuery()
+	if err != nil {
+		return JSONPath{}, errors.Wrapf(ErrWrongJSONPath, "%s at position %d of %q", err.Error(), p.pos, query)
+	}
+	return path, nil
+}
+
+// IsDefinite returns true if the query can match at most one value, i.e. it only contains
+// member names and array indexes.
+func (p JSONPath) IsDefinite() bool {
+	for _, s := range p.segments {
+		if s.recursive || len(s.selectors) != 1 {
+			return false
+		}
+		if k := s.selectors[0].kind; k != selectName && k != selectIndex {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate returns the values matched by the query, in document order. Members of objects are
+// visited in the order of their keys. If the query ends in a function, its result is the only value returned.
+func (p JSONPath) Evaluate(root interface{}) ([]interface{}, error) {
+	nodes := evaluateJSONPathSegments(p.segments, root, root)
+	if p.function == "" {
+		return nodes, nil
+	}
+
+	values := nodes
+	if p.IsDefinite() && len(nodes) == 1 {
+		// functions apply to the elements of a single matched array
+		if list, ok := nodes[0].([]interface{}); ok && p.function != "length" {
+			values = list
+		}
+	}
+
+	result, err := applyJSONPathFunction(p.function, p.IsDefinite(), values)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{result}, nil
+}
+
+func evaluateJSONPathSegments(segments []jsonPathSegment, root, current interface{}) []interface{} {
+	nodes := []interface{}{current}
+	for _, segment := range segments {
+		next := []interface{}{}
+		for _, node := range nodes {
+			if segment.recursive {
+				for _, descendant := range jsonPathDescendants(node) {
+					next = append(next, segment.apply(root, descendant)...)
+				}
+			} else {
+				next = append(next, segment.apply(root, node)...)
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+func (s jsonPathSegment) apply(root, node interface{}) []interface{} {
+	matched := []interface{}{}
+	for _, sel := range s.selectors {
+		matched = append(matched, sel.apply(root, node)...)
+	}
+	return matched
+}
+
+func (s jsonPathSelector) apply(root, node interface{}) []interface{} {
+	switch s.kind {
+	case selectName:
+		if m, ok := node.(map[string]interface{}); ok {
+			if v, exists := m[s.name]; exists {
+				return []interface{}{v}
+			}
+		}
+	case selectIndex:
+		if l, ok := node.([]interface{}); ok {
+			i := s.index
+			if i < 0 {
+				i += len(l)
+			}
+			if i >= 0 && i < len(l) {
+				return []interface{}{l[i]}
+			}
+		}
+	case selectWildcard:
+		return jsonPathChildren(node)
+	case selectSlice:
+		if l, ok := node.([]interface{}); ok {
+			return s.slice(l)
+		}
+	case selectFilter:
+		matched := []interface{}{}
+		for _, child := range jsonPathChildren(node) {
+			if s.filter.test(root, child) {
+				matched = append(matched, child)
+			}
+		}
+		return matched
+	}
+	return nil
+}
+
+// slice selects the elements of the list within the slice bounds, as described by RFC 9535 section 2.3.4.2.
+func (s jsonPathSelector) slice(l []interface{}) []interface{} {
+	step := 1
+	if s.step != nil {
+		step = *s.step
+	}
+	if step == 0 {
+		return nil
+	}
+	if len(l) == 0 {
+		return []interface{}{}
+	}
+
+	normalize := func(i int) int {
+		if i < 0 {
+			return i + len(l)
+		}
+		return i
+	}
+	clamp := func(i, lower, upper int) int {
+		return min(max(i, lower), upper)
+	}
+
+	// the number of elements is computed first, so that large steps can't overflow the index
+	matched := []interface{}{}
+	if step > 0 {
+		start, end := 0, len(l)
+		if s.start != nil {
+			start = clamp(normalize(*s.start), 0, len(l))
+		}
+		if s.end != nil {
+			end = clamp(normalize(*s.end), 0, len(l))
+		}
+		step = min(step, len(l))
+		for n := 0; n < (end-start+step-1)/step; n++ {
+			matched = append(matched, l[start+n*step])
+		}
+	} else {
+		start, end := len(l)-1, -1
+		if s.start != nil {
+			start = clamp(normalize(*s.start), -1, len(l)-1)
+		}
+		if s.end != nil {
+			end = clamp(normalize(*s.end), -1, len(l)-1)
+		}
+		step = max(step, -len(l))
+		for n := 0; n < (start-end-step-1)/-step; n++ {
+			matched = append(matched, l[start+n*step])
+		}
+	}
+	return matched
+}
+
+// jsonPathChildren returns the elements of a list, or the values of an object ordered by key.
+func jsonPathChildren(node interface{}) []interface{} {
+	switch v := node.(type) {
+	case []interface{}:
+		return v
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		children := make([]interface{}, len(keys))
+		for i, k := range keys {
+			children[i] = v[k]
+		}
+		return children
+	}
+	return nil
+}
+
+// jsonPathDescendants returns the node itself followed by all of its descendants, depth first.
+func jsonPathDescendants(node interface{}) []interface{} {
+	descendants := []interface{}{node}
+	for _, child := range jsonPathChildren(node) {
+		descendants = append(descendants, jsonPathDescendants(child)...)
+	}
+	return descendants
+}
+
+func applyJSONPathFunction(function string, definite bool, values []interface{}) (interface{}, error) {
+	if function == "length" {
+		if !definite {
+			return decimal.NewFromInt(int64(len(values))), nil
+		}
+		if len(values) == 0 {
+			return nil, errors.Wrap(ErrKeypathNotFound, "length() of a missing value")
+		}
+		switch v := values[0].(type) {
+		case []interface{}:
+			return decimal.NewFromInt(int64(len(v))), nil
+		case map[string]interface{}:
+			return decimal.NewFromInt(int64(len(v))), nil
+		case string:
+			return decimal.NewFromInt(int64(len(v))), nil
+		}
+		return nil, errors.Wrapf(ErrBadInput, "length() of %T", values[0])
+	}
+
+	if len(values) == 0 {
+		return nil, errors.Wrapf(ErrWrongInputCardinality, "no values to apply %s() to", function)
+	}
+	ds := make([]decimal.Decimal, len(values))
+	for i, v := range values {
+		d, ok := jsonPathNumber(v)
+		if !ok {
+			if s, isString := v.(string); isString {
+				var err error
+				if d, err = decimal.NewFromString(s); err != nil {
+					return nil, errors.Wrapf(ErrBadInput, "%s(): %q is not a number", function, s)
+				}
+			} else {
+				return nil, errors.Wrapf(ErrBadInput, "%s(): %v is not a number", function, v)
+			}
+		}
+		ds[i] = d
+	}
+
+	switch function {
+	case "min":
+		return decimal.Min(ds[0], ds[1:]...), nil
+	case "max":
+		return decimal.Max(ds[0], ds[1:]...), nil
+	case "sum":
+		return decimal.Sum(ds[0], ds[1:]...), nil
+	case "avg":
+		return decimal.Avg(ds[0], ds[1:]...), nil
+	case "median":
+		sort.Slice(ds, func(i, j int) bool {
+			return ds[i].LessThan(ds[j])
+		})
+		k := len(ds) / 2
+		if len(ds)%2 == 1 {
+			return ds[k], nil
+		}
+		return ds[k-1].Add(ds[k]).Div(decimal.NewFromInt(2)), nil
+	}
+	return nil, errors.Errorf("unknown function %s()", function)
+}
+
+func jsonPathNumber(v interface{}) (decimal.Decimal, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		d, err := decimal.NewFromString(n.String())
+		return d, err == nil
+	case float64:
+		return decimal.NewFromFloat(n), true
+	case decimal.Decimal:
+		return n, true
+	}
+	return decimal.Decimal{}, false
+}
+
+// jsonPathExpr is a filter expression.
+type jsonPathExpr interface {
+	test(root, current interface{}) bool
+}
+
+type jsonPathOr struct{ left, right jsonPathExpr }
+
+func (e jsonPathOr) test(root, current interface{}) bool {
+	return e.left.test(root, current) || e.right.test(root, current)
+}
+
+type jsonPathAnd struct{ left, right jsonPathExpr }
+
+func (e jsonPathAnd) test(root, current interface{}) bool {
+	return e.left.test(root, current) && e.right.test(root, current)
+}
+
+type jsonPathNot struct{ expr jsonPathExpr }
+
+func (e jsonPathNot) test(root, current interface{}) bool {
+	return !e.expr.test(root, current)
+}
+
+// jsonPathExists is true if the query matches any values.
+type jsonPathExists struct{ operand jsonPathOperand }
+
+func (e jsonPathExists) test(root, current interface{}) bool {
+	return len(e.operand.path.evaluate(root, current)) > 0
+}
+
+type jsonPathComparison struct {
+	op          string
+	left, right jsonPathOperand
+}
+
+func (e jsonPathComparison) test(root, current interface{}) bool {
+	left, lok := e.left.value(root, current)
+	right, rok := e.right.value(root, current)
+
+	switch e.op {
+	case "==":
+		return jsonPathEqual(left, lok, right, rok)
+	case "!=":
+		return !jsonPathEqual(left, lok, right, rok)
+	case "<":
+		return jsonPathLess(left, lok, right, rok)
+	case ">":
+		return jsonPathLess(right, rok, left, lok)
+	case "<=":
+		return jsonPathLess(left, lok, right, rok) || jsonPathEqual(left, lok, right, rok)
+	case ">=":
+		return jsonPathLess(right, rok, left, lok) || jsonPathEqual(left, lok, right, rok)
+	}
+	return false
+}
+
+// jsonPathOperand is either a literal or a query relative to the root ($) or current (@) node.
+type jsonPathOperand struct {
+	literal interface{}
+	path    *jsonPathRelative
+}
+
+type jsonPathRelative struct {
+	fromRoot bool
+	segments []jsonPathSegment
+}
+
+func (r *jsonPathRelative) evaluate(root, current interface{}) []interface{} {
+	if r.fromRoot {
+		return evaluateJSONPathSegments(r.segments, root, root)
+	}
+	return evaluateJSONPathSegments(r.segments, root, current)
+}
+
+// value returns the operand's value, and false if it's a query which doesn't match exactly one value.
+func (o jsonPathOperand) value(root, current interface{}) (interface{}, bool) {
+	if o.path == nil {
+		return o.literal, true
+	}
+	nodes := o.path.evaluate(root, current)
+	if len(nodes) != 1 {
+		return nil, false
+	}
+	return nodes[0], true
+}
+
+func jsonPathEqual(a interface{}, aok bool, b interface{}, bok bool) bool {
+	if !aok || !bok {
+		// two queries which match nothing are equal
+		return aok == bok
+	}
+	an, aIsNumber := jsonPathNumber(a)
+	bn, bIsNumber := jsonPathNumber(b)
+	if aIsNumber && bIsNumber {
+		return an.Equal(bn)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func jsonPathLess(a interface{}, aok bool, b interface{}, bok bool) bool {
+	if !aok || !bok {
+		return false
+	}
+	an, aIsNumber := jsonPathNumber(a)
+	bn, bIsNumber := jsonPathNumber(b)
+	if aIsNumber && bIsNumber {
+		return an.LessThan(bn)
+	}
+	as, aIsString := a.(string)
+	bs, bIsString := b.(string)
+	return aIsString && bIsString && as < bs
+}
+
+type jsonPathParser struct {
+	input string
+	pos   int
+}
+
+func (p *jsonPathParser) parseQuery() (JSONPath, error) {
+	p.skipSpace()
+	if !p.consume("$") {
+		return JSONPath{}, errors.New("query must start with $")
+	}
+
+	segments, function, err := p.parseSegments(true)
+	if err != nil {
+		return JSONPath{}, err
+	}
+
+	p.skipSpace()
+	if p.pos < len(p.input) {
+		return JSONPath{}, fmt.Errorf("unexpected %q", p.input[p.pos])
+	}
+	return JSONPath{segments: segments, function: function}, nil
+}
+
+// parseSegments parses segments until one can't be parsed. If allowFunction is set, the segments may
+// be followed by a function call.
+func (p *jsonPathParser) parseSegments(allowFunction bool) ([]jsonPathSegment, string, error) {
+	segments := []jsonPathSegment{}
+	for p.pos < len(p.input) {
+		switch {
+		case p.consume(".."):
+			segment, err := p.parseDescendantSegment()
+			if err != nil {
+				return nil, "", err
+			}
+			segments = append(segments, segment)
+		case p.consume("."):
+			if p.consume("*") {
+				segments = append(segments, jsonPathSegment{selectors: []jsonPathSelector{{kind: selectWildcard}}})
+				continue
+			}
+			name := p.parseName()
+			if name == "" {
+				return nil, "", errors.New("expected a member name")
+			}
+			if p.consume("(") {
+				if !allowFunction {
+					return nil, "", errors.New("functions can't be used in filters")
+				}
+				if !jsonPathFunctions[name] {
+					return nil, "", fmt.Errorf("unknown function %s()", name)
+				}
+				if !p.consume(")") {
+					return nil, "", errors.New("expected )")
+				}
+				return segments, name, nil
+			}
+			segments = append(segments, jsonPathSegment{selectors: []jsonPathSelector{{kind: selectName, name: name}}})
+		case p.peek() == '[':
+			selectors, err := p.parseBracketedSelection()
+			if err != nil {
+				return nil, "", err
+			}
+			segments = append(segments, jsonPathSegment{selectors: selectors})
+		default:
+			return segments, "", nil
+		}
+	}
+	return segments, "", nil
+}
+
+func (p *jsonPathParser) parseDescendantSegment() (jsonPathSegment, error) {
+	switch {
+	case p.consume("*"):
+		return jsonPathSegment{recursive: true, selectors: []jsonPathSelector{{kind: selectWildcard}}}, nil
+	case p.peek() == '[':
+		selectors, err := p.parseBracketedSelection()
+		if err != nil {
+			return jsonPathSegment{}, err
+		}
+		return jsonPathSegment{recursive: true, selectors: selectors}, nil
+	}
+	name := p.parseName()
+	if name == "" {
+		return jsonPathSegment{}, errors.New("expected a member name")
+	}
+	return jsonPathSegment{recursive: true, selectors: []jsonPathSelector{{kind: selectName, name: name}}}, nil
+}
+
+func (p *jsonPathParser) parseName() string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		r := rune(p.input[p.pos])
+		if r != '_' && r != '-' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *jsonPathParser) parseBracketedSelection() ([]jsonPathSelector, error) {
+	p.consume("[")
+	selectors := []jsonPathSelector{}
+	for {
+		p.skipSpace()
+		selector, err := p.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+
+		p.skipSpace()
+		if p.consume("]") {
+			return selectors, nil
+		}
+		if !p.consume(",") {
+			return nil, errors.New("expected , or ]")
+		}
+	}
+}
+
+func (p *jsonPathParser) parseSelector() (jsonPathSelector, error) {
+	switch c := p.peek(); {
+	case c == '*':
+		p.pos++
+		return jsonPathSelector{kind: selectWildcard}, nil
+	case c == '\'' || c == '"':
+		name, err := p.parseString()
+		return jsonPathSelector{kind: selectName, name: name}, err
+	case c == '?':
+		p.pos++
+		filter, err := p.parseOr()
+		return jsonPathSelector{kind: selectFilter, filter: filter}, err
+	}
+
+	// index or slice
+	var bounds [3]*int
+	for i := 0; i < 3; i++ {
+		p.skipSpace()
+		if c := p.peek(); c == '-' || (c >= '0' && c <= '9') {
+			n, err := p.parseInt()
+			if err != nil {
+				return jsonPathSelector{}, err
+			}
+			bounds[i] = &n
+		}
+		p.skipSpace()
+		if i == 2 || !p.consume(":") {
+			if i == 0 {
+				if bounds[0] == nil {
+					return jsonPathSelector{}, errors.New("expected a selector")
+				}
+				return jsonPathSelector{kind: selectIndex, index: *bounds[0]}, nil
+			}
+			break
+		}
+	}
+	return jsonPathSelector{kind: selectSlice, start: bounds[0], end: bounds[1], step: bounds[2]}, nil
+}
+
+func (p *jsonPathParser) parseInt() (int, error) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		p.pos++
+	}
+	i, err := strconv.Atoi(p.input[start:p.pos])
+	if errors.Is(err, strconv.ErrRange) {
+		// Atoi saturates out of range values, which are then bounded like any other
+		err = nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return min(max(i, -maxJSONPathInt), maxJSONPathInt), nil
+}
+
+func (p *jsonPathParser) parseString() (string, error) {
+	quote := p.input[p.pos]
+	p.pos++
+	var sb strings.Builder
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		p.pos++
+		switch c {
+		case quote:
+			return sb.String(), nil
+		case '\\':
+			if p.pos == len(p.input) {
+				return "", errors.New("unterminated string")
+			}
+			sb.WriteByte(p.input[p.pos])
+			p.pos++
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", errors.New("unterminated string")
+}
+
+func (p *jsonPathParser) parseOr() (jsonPathExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.skipSpace(); p.consume("||"); p.skipSpace() {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = jsonPathOr{left, right}
+	}
+	return left, nil
+}
+
+func (p *jsonPathParser) parseAnd() (jsonPathExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.skipSpace(); p.consume("&&"); p.skipSpace() {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = jsonPathAnd{left, right}
+	}
+	return left, nil
+}
+
+func (p *jsonPathParser) parseUnary() (jsonPathExpr, error) {
+	p.skipSpace()
+	switch {
+	case p.peek() == '!' && !strings.HasPrefix(p.input[p.pos:], "!="):
+		p.pos++
+		expr, err := p.parseUnary()
+		return jsonPathNot{expr}, err
+	case p.consume("("):
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(")") {
+			return nil, errors.New("expected )")
+		}
+		return expr, nil
+	}
+
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.consume(op) {
+			p.skipSpace()
+			right, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			return jsonPathComparison{op: op, left: left, right: right}, nil
+		}
+	}
+
+	if left.path == nil {
+		return nil, errors.New("literals must be compared to a value")
+	}
+	return jsonPathExists{left}, nil
+}
+
+func (p *jsonPathParser) parseOperand() (jsonPathOperand, error) {
+	switch c := p.peek(); {
+	case c == '@' || c == '$':
+		p.pos++
+		segments, _, err := p.parseSegments(false)
+		if err != nil {
+			return jsonPathOperand{}, err
+		}
+		return jsonPathOperand{path: &jsonPathRelative{fromRoot: c == '$', segments: segments}}, nil
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		return jsonPathOperand{literal: s}, err
+	case c == '-' || (c >= '0' && c <= '9'):
+		start := p.pos
+		p.pos++
+		for c := p.peek(); (c >= '0' && c <= '9') || c == '.' || c == 'e' || c == 'E' || c == '+' || c == '-'; c = p.peek() {
+			p.pos++
+		}
+		d, err := decimal.NewFromString(p.input[start:p.pos])
+		if err != nil {
+			return jsonPathOperand{}, fmt.Errorf("invalid number %q", p.input[start:p.pos])
+		}
+		return jsonPathOperand{literal: d}, nil
+	}
+
+	for literal, value := range map[string]interface{}{"true": true, "false": false, "null": nil} {
+		if p.consume(literal) {
+			return jsonPathOperand{literal: value}, nil
+		}
+	}
+	return jsonPathOperand{}, errors.New("expected a query or literal")
+}
+
+func (p *jsonPathParser) peek() byte {
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+func (p *jsonPathParser) consume(s string) bool {
+	if strings.HasPrefix(p.input[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *jsonPathParser) skipSpace() {
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
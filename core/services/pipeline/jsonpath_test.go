@@ -0,0 +1,165 @@
+package pipeline_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const jsonPathDocument = `{
+	"data": [
+		{"exchange": "a", "price": 100.5, "volume": 10},
+		{"exchange": "x", "price": 1},
+		{"exchange": "b", "price": 101.5, "volume": 0},
+		{"exchange": "c", "price": "102", "tags": ["fast"]}
+	],
+	"meta": {"count": 4, "source": {"name": "agg"}}
+}`
+
+func TestJSONPath_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	var doc interface{}
+	d := json.NewDecoder(bytes.NewReader([]byte(jsonPathDocument)))
+	d.UseNumber()
+	require.NoError(t, d.Decode(&doc))
+
+	tests := []struct {
+		query    string
+		want     string
+		definite bool
+	}{
+		{"$", jsonPathDocument, true},
+		{"$.meta.count", `4`, true},
+		{"$['meta'][\"source\"].name", `"agg"`, true},
+		{"$.data[-1].exchange", `"c"`, true},
+		{"$.data[9].exchange", `null`, true},
+		{"$.data[*].exchange", `["a", "x", "b", "c"]`, false},
+		{"$.data.*.exchange", `["a", "x", "b", "c"]`, false},
+		{"$.data[1:3].exchange", `["x", "b"]`, false},
+		{"$.data[::-2].exchange", `["c", "x"]`, false},
+		{"$.data[:-3].exchange", `["a"]`, false},
+		{"$.data[0,2].exchange", `["a", "b"]`, false},
+		{"$.data[0]['exchange','price']", `["a", 100.5]`, false},
+		{"$..name", `["agg"]`, false},
+		{"$.meta..*", `[4, {"name": "agg"}, "agg"]`, false},
+		{"$.data[?(@.exchange != 'x')].price", `[100.5, 101.5, "102"]`, false},
+		{"$.data[?@.price > 100 && @.volume].exchange", `["a", "b"]`, false},
+		{"$.data[?(@.price >= 101.5 || @.exchange == \"x\")].exchange", `["x", "b"]`, false},
+		{"$.data[?(!@.volume)].exchange", `["x", "c"]`, false},
+		{"$.data[?(@.volume == 0)].exchange", `["b"]`, false},
+		{"$.data[?(@.tags[0] == 'fast')].exchange", `["c"]`, false},
+		{"$.data[?(@.price < $.meta.count)].exchange", `["x"]`, false},
+		{"$.data[?(@.exchange > 'a' && !(@.exchange == 'x'))].exchange", `["b", "c"]`, false},
+		{"$.data[?(@.price)].length()", `"4"`, false},
+		{"$.data.length()", `"4"`, true},
+		{"$.data[?(@.exchange != 'x')].price.median()", `"101.5"`, false},
+		{"$.data[*].price.max()", `"102"`, false},
+		{"$.data[*].price.min()", `"1"`, false},
+		{"$.data[*].price.sum()", `"305"`, false},
+		{"$.data[*].volume.avg()", `"5"`, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.query, func(t *testing.T) {
+			t.Parallel()
+
+			path, err := pipeline.ParseJSONPath(test.query)
+			require.NoError(t, err)
+			assert.Equal(t, test.definite, path.IsDefinite())
+
+			matches, err := path.Evaluate(doc)
+			require.NoError(t, err)
+
+			var got interface{} = matches
+			// definite queries and functions match at most one value
+			if test.definite || strings.HasSuffix(test.query, "()") {
+				got = nil
+				if len(matches) > 0 {
+					got = matches[0]
+				}
+			}
+			gotJSON, err := json.Marshal(got)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.want, string(gotJSON))
+		})
+	}
+}
+
+func TestJSONPath_EvaluateLargeIndexes(t *testing.T) {
+	t.Parallel()
+
+	doc := []interface{}{1, 2, 3}
+	tests := []struct {
+		query string
+		want  []interface{}
+	}{
+		{"$[1::9223372036854775807]", []interface{}{2}},
+		{"$[1::-9223372036854775808]", []interface{}{2}},
+		{"$[::99999999999999999999]", []interface{}{1}},
+		{"$[-9223372036854775808:9223372036854775807:2]", []interface{}{1, 3}},
+		{"$[9223372036854775807:-9223372036854775808:-1]", []interface{}{3, 2, 1}},
+		{"$[9223372036854775807]", []interface{}{}},
+		{"$[-9223372036854775808]", []interface{}{}},
+	}
+
+	for _, test := range tests {
+		path, err := pipeline.ParseJSONPath(test.query)
+		require.NoError(t, err, test.query)
+		matches, err := path.Evaluate(doc)
+		require.NoError(t, err, test.query)
+		assert.Equal(t, test.want, matches, test.query)
+	}
+}
+
+func TestJSONPath_EvaluateFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	var doc interface{}
+	require.NoError(t, json.Unmarshal([]byte(jsonPathDocument), &doc))
+
+	for _, query := range []string{
+		"$.data[*].exchange.sum()",
+		"$.missing[*].median()",
+		"$.meta.count.length()",
+	} {
+		path, err := pipeline.ParseJSONPath(query)
+		require.NoError(t, err)
+		_, err = path.Evaluate(doc)
+		assert.Error(t, err, query)
+	}
+}
+
+func TestParseJSONPath_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query string
+		err   string
+	}{
+		{"data.price", "query must start with $"},
+		{"$.", "expected a member name"},
+		{"$.data[", "expected a selector"},
+		{"$.data[0", "expected , or ]"},
+		{"$['data", "unterminated string"},
+		{"$.data[?(@.price > )]", "expected a query or literal"},
+		{"$.data[?(@.price > 1]", "expected )"},
+		{"$.data[?('x')]", "literals must be compared to a value"},
+		{"$.data[?(@.price.max() > 1)]", "functions can't be used in filters"},
+		{"$.data.mode()", "unknown function mode()"},
+		{"$.data.length() .x", "unexpected"},
+	}
+
+	for _, test := range tests {
+		_, err := pipeline.ParseJSONPath(test.query)
+		require.ErrorIs(t, err, pipeline.ErrWrongJSONPath, test.query)
+		assert.Contains(t, err.Error(), test.err, test.query)
+	}
+}
@@ -4,6 +4,8 @@ package mocks
 
 import (
 	config "github.com/smartcontractkit/chainlink-common/pkg/config"
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
+
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
@@ -50,6 +52,46 @@ func (_m *Config) DefaultHTTPTimeout() config.Duration {
 	return r0
 }
 
+// HTTPBridgeLimits provides a mock function with given fields:
+func (_m *Config) HTTPBridgeLimits() map[string]coreconfig.JobPipelineRequestLimit {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPBridgeLimits")
+	}
+
+	var r0 map[string]coreconfig.JobPipelineRequestLimit
+	if rf, ok := ret.Get(0).(func() map[string]coreconfig.JobPipelineRequestLimit); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]coreconfig.JobPipelineRequestLimit)
+		}
+	}
+
+	return r0
+}
+
+// HTTPHostLimits provides a mock function with given fields:
+func (_m *Config) HTTPHostLimits() map[string]coreconfig.JobPipelineRequestLimit {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPHostLimits")
+	}
+
+	var r0 map[string]coreconfig.JobPipelineRequestLimit
+	if rf, ok := ret.Get(0).(func() map[string]coreconfig.JobPipelineRequestLimit); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]coreconfig.JobPipelineRequestLimit)
+		}
+	}
+
+	return r0
+}
+
 // MaxRunDuration provides a mock function with given fields:
 func (_m *Config) MaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -241,6 +241,36 @@ func (_m *Runner) Ready() error {
 	return r0
 }
 
+// ReplayRun provides a mock function with given fields: ctx, original, l
+func (_m *Runner) ReplayRun(ctx context.Context, original pipeline.Run, l logger.Logger) (*pipeline.Run, error) {
+	ret := _m.Called(ctx, original, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Run, logger.Logger) (*pipeline.Run, error)); ok {
+		return rf(ctx, original, l)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Run, logger.Logger) *pipeline.Run); ok {
+		r0 = rf(ctx, original, l)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Run, logger.Logger) error); ok {
+		r1 = rf(ctx, original, l)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResumeRun provides a mock function with given fields: ctx, taskID, value, err
 func (_m *Runner) ResumeRun(ctx context.Context, taskID uuid.UUID, value interface{}, err error) error {
 	ret := _m.Called(ctx, taskID, value, err)
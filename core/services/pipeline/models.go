@@ -271,6 +271,7 @@ type TaskRun struct {
 	PipelineRunID int64                             `json:"-"`
 	Output        jsonserializable.JSONSerializable `json:"output"`
 	Error         null.String                       `json:"error"`
+	Skipped       bool                              `json:"skipped"`
 	CreatedAt     time.Time                         `json:"createdAt"`
 	FinishedAt    null.Time                         `json:"finishedAt"`
 	Index         int32                             `json:"index"`
@@ -298,7 +299,7 @@ func (tr TaskRun) GetDotID() string {
 }
 
 func (tr TaskRun) Result() Result {
-	var result Result
+	result := Result{Skipped: tr.Skipped}
 	if !tr.Error.IsZero() {
 		result.Error = errors.New(tr.Error.ValueOrZero())
 	} else if tr.Output.Valid && tr.Output.Val != nil {
@@ -219,8 +219,8 @@ func (o *orm) CreateRun(ctx context.Context, run *Run) (err error) {
 			run.PipelineTaskRuns[i].PipelineRunID = run.ID
 		}
 
-		sql := `INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at);`
+		sql := `INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, skipped, dot_id, created_at)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :skipped, :dot_id, :created_at);`
 		_, err = tx.ds.NamedExecContext(ctx, sql, run.PipelineTaskRuns)
 		return err
 	})
@@ -299,10 +299,10 @@ func (o *orm) StoreRun(ctx context.Context, run *Run) (restart bool, err error)
 		}
 
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, skipped, dot_id, created_at, finished_at)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :skipped, :dot_id, :created_at, :finished_at)
 		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
-		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at
+		output = EXCLUDED.output, error = EXCLUDED.error, skipped = EXCLUDED.skipped, finished_at = EXCLUDED.finished_at
 		RETURNING *;
 		`
 
@@ -406,8 +406,8 @@ RETURNING id
 		}()
 
 		pipelineTaskRunsQuery := `
-INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);
+INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, skipped, dot_id, created_at, finished_at)
+VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :skipped, :dot_id, :created_at, :finished_at);
 	`
 		var pipelineTaskRuns []TaskRun
 		for _, run := range runs {
@@ -512,8 +512,8 @@ func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 
 	defer o.prune(o.ds, run.PruningKey)
 	sql = `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);`
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, skipped, dot_id, created_at, finished_at)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :skipped, :dot_id, :created_at, :finished_at);`
 	_, err = o.ds.NamedExecContext(ctx, sql, run.PipelineTaskRuns)
 	return errors.Wrap(err, "failed to insert pipeline_task_runs")
 }
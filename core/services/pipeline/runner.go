@@ -53,6 +53,11 @@ type Runner interface {
 	// This will persist the Spec in the DB if it doesn't have an ID.
 	ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger, saveSuccessfulTaskRuns bool) (runID int64, results TaskRunResults, err error)
 
+	// ReplayRun re-executes a finished run in-memory with its original inputs. Tasks which call out to the
+	// network or have side effects aren't executed, and return the results recorded in the original run
+	// instead, so its task runs must have been saved. The replayed run isn't saved.
+	ReplayRun(ctx context.Context, original Run, l logger.Logger) (*Run, error)
+
 	OnRunFinished(func(*Run))
 	InitializePipeline(spec Spec) (*Pipeline, error)
 }
@@ -70,6 +75,8 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	httpCache              *httpResponseCache
+	httpLimiter            *httpRequestLimiter
 
 	// test helper
 	runFinished func(*Run)
@@ -123,6 +130,8 @@ func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, bridgeCfg BridgeConfig, l
 		lggr:                   lggr.Named("PipelineRunner"),
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		httpCache:              newHTTPResponseCache(),
+		httpLimiter:            newHTTPRequestLimiter(cfg.HTTPHostLimits(), cfg.HTTPBridgeLimits()),
 	}
 	r.runReaperWorker = commonutils.NewSleeperTask(
 		commonutils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
@@ -262,7 +271,7 @@ func (r *runner) ExecuteRun(
 	}
 
 	run := NewRun(spec, vars)
-	taskRunResults := r.run(ctx, pipeline, run, vars, l)
+	taskRunResults := r.run(ctx, pipeline, run, vars, l, nil)
 
 	if run.Pending {
 		return run, nil, fmt.Errorf("unexpected async run for spec ID %v, tried executing via ExecuteRun", spec.ID)
@@ -271,6 +280,58 @@ func (r *runner) ExecuteRun(
 	return run, taskRunResults, nil
 }
 
+// IsReplayedTaskType returns true if tasks of the given type aren't executed when a run is replayed,
+// because they call out to the network or have side effects.
+func IsReplayedTaskType(taskType TaskType) bool {
+	switch taskType {
+	case TaskTypeHTTP, TaskTypeBridge, TaskTypeETHCall, TaskTypeETHGetBlock, TaskTypeETHGetLogs, TaskTypeEstimateGasLimit, TaskTypeETHTx:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *runner) ReplayRun(ctx context.Context, original Run, l logger.Logger) (*Run, error) {
+	if !original.FinishedAt.Valid {
+		return nil, pkgerrors.Errorf("run %d hasn't finished", original.ID)
+	}
+	if len(original.PipelineTaskRuns) == 0 {
+		return nil, pkgerrors.Errorf("run %d has no saved task runs to replay", original.ID)
+	}
+
+	spec := original.PipelineSpec
+	// always parse the spec, since the tasks are modified when initialized
+	spec.Pipeline = nil
+	pipeline, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := make(map[string]Result)
+	for _, taskRun := range original.PipelineTaskRuns {
+		if IsReplayedTaskType(taskRun.Type) && !taskRun.Skipped && taskRun.FinishedAt.Valid {
+			recorded[taskRun.DotID] = taskRun.Result()
+		}
+	}
+
+	// the inputs of a run also hold the results of its tasks once it has run
+	inputs, _ := original.Inputs.Val.(map[string]interface{})
+	vars := make(map[string]interface{}, len(inputs))
+	for key, value := range inputs {
+		if pipeline.ByDotID(key) == nil {
+			vars[key] = value
+		}
+	}
+
+	replayVars := NewVarsFrom(vars)
+	run := NewRun(spec, replayVars)
+	r.run(ctx, pipeline, run, replayVars, l, recorded)
+	if run.Pending {
+		return nil, pkgerrors.Errorf("unexpected async run when replaying run %d", original.ID)
+	}
+	return run, nil
+}
+
 func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	pipeline, err = spec.GetOrParsePipeline()
 	if err != nil {
@@ -286,6 +347,8 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+			task.(*HTTPTask).cache = r.httpCache
+			task.(*HTTPTask).limiter = r.httpLimiter
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
@@ -295,11 +358,16 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).limiter = r.httpLimiter
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
 			task.(*ETHCallTask).specGasLimit = spec.GasLimit
 			task.(*ETHCallTask).jobType = spec.JobType
+		case TaskTypeETHGetBlock:
+			task.(*ETHGetBlockTask).legacyChains = r.legacyEVMChains
+		case TaskTypeETHGetLogs:
+			task.(*ETHGetLogsTask).legacyChains = r.legacyEVMChains
 		case TaskTypeVRF:
 			task.(*VRFTask).keyStore = r.vrfKeyStore
 		case TaskTypeVRFV2:
@@ -323,7 +391,9 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	return pipeline, nil
 }
 
-func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, l logger.Logger) TaskRunResults {
+// run executes the pipeline. If recorded is not nil, tasks of replayed types return the results in it
+// instead of being executed, as when replaying runs or testing specs.
+func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, l logger.Logger, recorded map[string]Result) TaskRunResults {
 	l = l.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
@@ -344,7 +414,12 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		taskRun := taskRun
 		// execute
 		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
+			var result TaskRunResult
+			if recorded != nil && IsReplayedTaskType(taskRun.task.Type()) {
+				result = replayedTaskRunResult(taskRun, recorded)
+			} else {
+				result = r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
+			}
 
 			logTaskRunToPrometheus(result, run.PipelineSpec)
 
@@ -387,6 +462,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 			Index:         result.Task.OutputIndex(),
 			Output:        output,
 			Error:         result.Result.ErrorDB(),
+			Skipped:       result.Result.Skipped,
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
@@ -531,6 +607,22 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	}
 }
 
+// replayedTaskRunResult returns the result the task had in the original run of a replay.
+func replayedTaskRunResult(taskRun *memoryTaskRun, recorded map[string]Result) TaskRunResult {
+	result, ok := recorded[taskRun.task.DotID()]
+	if !ok {
+		result = Result{Error: pkgerrors.Errorf("no result was recorded for task %s", taskRun.task.DotID())}
+	}
+	now := time.Now()
+	return TaskRunResult{
+		ID:         taskRun.task.Base().uuid,
+		Task:       taskRun.task,
+		Result:     result,
+		CreatedAt:  now,
+		FinishedAt: null.TimeFrom(now),
+	}
+}
+
 func logTaskRunToPrometheus(trr TaskRunResult, spec Spec) {
 	elapsed := trr.FinishedAt.Time.Sub(trr.CreatedAt)
 
@@ -624,7 +716,7 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 	}
 
 	for {
-		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l)
+		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l, nil)
 
 		if preinsert {
 			// FailSilently = run failed and task was marked failEarly. skip StoreRun and instead delete all trace of it
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -1024,3 +1025,74 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_ReplayRun(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, lggr, nil, nil)
+
+	// the URL isn't reachable, so the http task must return its recorded result
+	const spec = `
+ds       [type=http method=GET url="http://127.0.0.1:1"];
+parse    [type=jsonparse path="price"];
+multiply [type=multiply times="$(jobRun.meta.factor)"];
+ds -> parse -> multiply;
+`
+	taskRun := func(dotID string, taskType pipeline.TaskType, output interface{}) pipeline.TaskRun {
+		return pipeline.TaskRun{
+			Type:       taskType,
+			DotID:      dotID,
+			Output:     jsonserializable.JSONSerializable{Val: output, Valid: true},
+			FinishedAt: null.TimeFrom(time.Now()),
+		}
+	}
+	original := pipeline.Run{
+		ID:           42,
+		PipelineSpec: pipeline.Spec{DotDagSource: spec},
+		Inputs: jsonserializable.JSONSerializable{Val: map[string]interface{}{
+			"jobRun":   map[string]interface{}{"meta": map[string]interface{}{"factor": 2}},
+			"ds":       `{"price": 10}`,
+			"parse":    10,
+			"multiply": "20",
+		}, Valid: true},
+		FinishedAt: null.TimeFrom(time.Now()),
+		PipelineTaskRuns: []pipeline.TaskRun{
+			taskRun("ds", pipeline.TaskTypeHTTP, `{"price": 10}`),
+			taskRun("parse", pipeline.TaskTypeJSONParse, 10),
+			taskRun("multiply", pipeline.TaskTypeMultiply, "20"),
+		},
+	}
+
+	t.Run("re-executes tasks with recorded network results", func(t *testing.T) {
+		run, err := r.ReplayRun(testutils.Context(t), original, lggr)
+		require.NoError(t, err)
+		require.Equal(t, pipeline.RunStatusCompleted, run.State)
+		require.Len(t, run.PipelineTaskRuns, 3)
+		assert.Equal(t, `{"price": 10}`, run.ByDotID("ds").Output.Val)
+		assert.Equal(t, "20", run.ByDotID("multiply").Output.Val.(decimal.Decimal).String())
+	})
+
+	t.Run("uses the current spec", func(t *testing.T) {
+		changed := original
+		changed.PipelineSpec.DotDagSource = strings.Replace(spec, `times="$(jobRun.meta.factor)"`, "times=3", 1)
+		run, err := r.ReplayRun(testutils.Context(t), changed, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, "30", run.ByDotID("multiply").Output.Val.(decimal.Decimal).String())
+	})
+
+	t.Run("fails for tasks without a recorded result", func(t *testing.T) {
+		missing := original
+		missing.PipelineTaskRuns = original.PipelineTaskRuns[1:]
+		run, err := r.ReplayRun(testutils.Context(t), missing, lggr)
+		require.NoError(t, err)
+		require.Equal(t, pipeline.RunStatusErrored, run.State)
+		assert.Contains(t, run.ByDotID("ds").Error.String, "no result was recorded for task ds")
+	})
+
+	t.Run("requires saved task runs", func(t *testing.T) {
+		unsaved := original
+		unsaved.PipelineTaskRuns = nil
+		_, err := r.ReplayRun(testutils.Context(t), unsaved, lggr)
+		require.ErrorContains(t, err, "no saved task runs")
+	})
+}
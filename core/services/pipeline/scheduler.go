@@ -33,7 +33,11 @@ func (s *scheduler) newMemoryTaskRun(task Task, vars Vars) *memoryTaskRun {
 		// if we're confident that indices are within range
 		for _, i := range task.Inputs() {
 			if i.PropagateResult {
-				inputs = append(inputs, input{index: i.InputTask.OutputIndex(), result: s.results[i.InputTask.ID()].Result})
+				result := s.results[i.InputTask.ID()].Result
+				if s.skippedEdges[taskEdge{from: i.InputTask.ID(), to: task.ID()}] {
+					result = Result{Skipped: true}
+				}
+				inputs = append(inputs, input{index: i.InputTask.OutputIndex(), result: result})
 			}
 		}
 		sort.Slice(inputs, func(i, j int) bool {
@@ -48,10 +52,18 @@ func (s *scheduler) newMemoryTaskRun(task Task, vars Vars) *memoryTaskRun {
 	return run
 }
 
+// taskEdge is an edge between two tasks, identified by their IDs.
+type taskEdge struct {
+	from, to int
+}
+
 type scheduler struct {
 	pipeline     *Pipeline
 	run          *Run
 	dependencies map[int]uint
+	// skippedEdges holds the edges along which execution doesn't continue, either because the
+	// input task was skipped or because it's a branching task which didn't take the branch.
+	skippedEdges map[taskEdge]bool
 	waiting      uint
 	results      map[int]TaskRunResult
 	vars         Vars
@@ -76,6 +88,7 @@ func newScheduler(p *Pipeline, run *Run, vars Vars, lggr logger.Logger) *schedul
 		pipeline:     p,
 		run:          run,
 		dependencies: dependencies,
+		skippedEdges: make(map[taskEdge]bool),
 		results:      make(map[int]TaskRunResult, len(p.Tasks)),
 		vars:         vars,
 		logger:       lggr,
@@ -88,7 +101,8 @@ func newScheduler(p *Pipeline, run *Run, vars Vars, lggr logger.Logger) *schedul
 	// if there's results already present on Run, then this is a resumption. Loop over them and fill results table
 	s.reconstructResults()
 
-	// immediately schedule all doable tasks
+	// immediately schedule all doable tasks. They're collected first since skipping a task makes its outputs doable.
+	var doable []Task
 	for id, task := range p.Tasks {
 		// skip tasks that are not ready
 		if s.dependencies[id] != 0 {
@@ -100,12 +114,10 @@ func newScheduler(p *Pipeline, run *Run, vars Vars, lggr logger.Logger) *schedul
 			continue
 		}
 
-		run := s.newMemoryTaskRun(task, s.vars.Copy())
-
-		lggr.Tracew("scheduling task run", "dot_id", task.DotID(), "attempts", run.attempts)
-
-		s.taskCh <- run
-		s.waiting++
+		doable = append(doable, task)
+	}
+	for _, task := range doable {
+		s.schedule(task)
 	}
 
 	return s
@@ -124,7 +136,7 @@ func (s *scheduler) reconstructResults() {
 			continue
 		}
 
-		result := Result{}
+		result := Result{Skipped: r.Skipped}
 
 		if r.Error.Valid {
 			result.Error = errors.New(r.Error.String)
@@ -153,9 +165,13 @@ func (s *scheduler) reconstructResults() {
 		}
 
 		// mark all outputs as complete
+		skipped := s.skippedOutputs(s.results[task.ID()])
 		for _, output := range task.Outputs() {
 			id := output.ID()
 			s.dependencies[id]--
+			if skipped[output.DotID()] {
+				s.skippedEdges[taskEdge{from: task.ID(), to: id}] = true
+			}
 		}
 	}
 }
@@ -255,23 +271,79 @@ func (s *scheduler) Run() {
 			continue
 		}
 
+		s.completeOutputs(result)
+	}
+
+	close(s.taskCh)
+}
+
+// completeOutputs marks the task as done for each of its outputs, and schedules the outputs with no remaining dependencies.
+func (s *scheduler) completeOutputs(result TaskRunResult) {
+	skipped := s.skippedOutputs(result)
+	for _, output := range result.Task.Outputs() {
+		id := output.ID()
+		s.dependencies[id]--
+		if skipped[output.DotID()] {
+			s.skippedEdges[taskEdge{from: result.Task.ID(), to: id}] = true
+		}
+
+		// if all dependencies are done, schedule task run
+		if s.dependencies[id] == 0 {
+			s.schedule(s.pipeline.Tasks[id])
+		}
+	}
+}
+
+// skippedOutputs returns the DOT IDs of the outputs which execution doesn't continue to after the task finished.
+func (s *scheduler) skippedOutputs(result TaskRunResult) map[string]bool {
+	skipped := map[string]bool{}
+	if result.Result.Skipped {
 		for _, output := range result.Task.Outputs() {
-			id := output.ID()
-			s.dependencies[id]--
+			skipped[output.DotID()] = true
+		}
+		return skipped
+	}
 
-			// if all dependencies are done, schedule task run
-			if s.dependencies[id] == 0 {
-				task := s.pipeline.Tasks[id]
-				run := s.newMemoryTaskRun(task, s.vars.Copy())
+	if b, ok := result.Task.(branchingTask); ok && result.Result.Error == nil {
+		return b.skippedOutputs(result.Result)
+	}
+	return skipped
+}
 
-				s.logger.Tracew("scheduling task run", "dot_id", run.task.DotID(), "attempts", run.attempts)
-				s.taskCh <- run
-				s.waiting++
-			}
+// schedule runs a task whose dependencies are all done, unless it's skipped. Tasks are skipped if
+// execution didn't continue along any of their inputs, or along all of them for join tasks.
+func (s *scheduler) schedule(task Task) {
+	skippedInputs := 0
+	for _, input := range task.Inputs() {
+		if s.skippedEdges[taskEdge{from: input.InputTask.ID(), to: task.ID()}] {
+			skippedInputs++
 		}
 	}
 
-	close(s.taskCh)
+	_, isJoin := task.(joinTask)
+	if skippedInputs == 0 || (isJoin && skippedInputs < len(task.Inputs())) {
+		run := s.newMemoryTaskRun(task, s.vars.Copy())
+
+		s.logger.Tracew("scheduling task run", "dot_id", run.task.DotID(), "attempts", run.attempts)
+		s.taskCh <- run
+		s.waiting++
+		return
+	}
+
+	s.logger.Tracew("skipping task run", "dot_id", task.DotID())
+	now := time.Now()
+	result := TaskRunResult{
+		ID:         task.Base().uuid,
+		Task:       task,
+		Result:     Result{Skipped: true},
+		CreatedAt:  now,
+		FinishedAt: null.TimeFrom(now),
+	}
+	s.results[task.ID()] = result
+	if err := s.vars.Set(task.DotID(), nil); err != nil {
+		s.logger.Panicf("Vars.Set error: %v", err)
+	}
+	s.completeOutputs(result)
 }
 
 func (s *scheduler) markRemaining(err error) {
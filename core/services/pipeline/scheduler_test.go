@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
@@ -128,6 +129,128 @@ func TestScheduler(t *testing.T) {
 				require.Equal(t, ErrCancelled, result.Result.Error)
 			},
 		},
+		{
+			name: "if: skip the branch which isn't taken up to the join",
+			spec: `
+			check [type=if then="yes" else="no"]
+			yes [type=median]
+			no [type=median]
+			after_no [type=median]
+			join [type=coalesce index=0]
+			check -> yes -> join
+			check -> no -> after_no -> join
+			`,
+			events: []event{
+				{
+					expected: "check",
+					result:   Result{Value: true},
+				},
+				{
+					expected: "yes",
+					result:   Result{Value: 1},
+				},
+				{
+					expected: "join",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				require.True(t, results[p.ByDotID("no").ID()].Result.Skipped)
+				require.True(t, results[p.ByDotID("after_no").ID()].Result.Skipped)
+				require.True(t, results[p.ByDotID("after_no").ID()].FinishedAt.Valid)
+				require.False(t, results[p.ByDotID("yes").ID()].Result.Skipped)
+				require.False(t, results[p.ByDotID("join").ID()].Result.Skipped)
+			},
+		},
+		{
+			name: "if: skip the join if all of its inputs are skipped",
+			spec: `
+			check [type=if then="yes"]
+			always [type=median]
+			yes [type=median]
+			join [type=coalesce index=0]
+			check -> always
+			check -> yes -> join
+			`,
+			events: []event{
+				{
+					expected: "check",
+					result:   Result{Value: false},
+				},
+				{
+					expected: "always",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				require.True(t, results[p.ByDotID("yes").ID()].Result.Skipped)
+				require.True(t, results[p.ByDotID("join").ID()].Result.Skipped)
+				require.False(t, results[p.ByDotID("always").ID()].Result.Skipped)
+			},
+		},
+		{
+			name: "if: run all branches if the condition errored",
+			spec: `
+			check [type=if then="yes" else="no"]
+			yes [type=median index=0]
+			no [type=median index=1]
+			check -> yes
+			check -> no
+			`,
+			events: []event{
+				{
+					expected: "check",
+					result:   Result{Error: ErrBadInput},
+				},
+				{
+					expected: "yes",
+					result:   Result{Error: ErrTooManyErrors},
+				},
+				{
+					expected: "no",
+					result:   Result{Error: ErrTooManyErrors},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				require.False(t, results[p.ByDotID("yes").ID()].Result.Skipped)
+				require.False(t, results[p.ByDotID("no").ID()].Result.Skipped)
+			},
+		},
+		{
+			name: "switch: run the matched case",
+			spec: `
+			route [type=switch cases=<{"buy": "buy", "sell": ["sell", "notify"]}> default="reject"]
+			buy [type=median]
+			sell [type=median]
+			notify [type=median index=1]
+			reject [type=median]
+			join [type=coalesce index=0]
+			route -> buy -> join
+			route -> sell -> join
+			route -> notify
+			route -> reject -> join
+			`,
+			events: []event{
+				{
+					expected: "route",
+					result:   Result{Value: "buy"},
+				},
+				{
+					expected: "buy",
+					result:   Result{Value: 1},
+				},
+				{
+					expected: "join",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				require.False(t, results[p.ByDotID("buy").ID()].Result.Skipped)
+				require.True(t, results[p.ByDotID("sell").ID()].Result.Skipped)
+				require.True(t, results[p.ByDotID("notify").ID()].Result.Skipped)
+				require.True(t, results[p.ByDotID("reject").ID()].Result.Skipped)
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -167,3 +290,58 @@ func TestScheduler(t *testing.T) {
 		test.assertion(t, *p, s.results)
 	}
 }
+
+func TestScheduler_ResumeWithSkippedTasks(t *testing.T) {
+	p, err := Parse(`
+	check [type=if then="yes" else="no"]
+	yes [type=median]
+	no [type=median]
+	after_no [type=median]
+	join [type=coalesce index=0]
+	check -> yes -> join
+	check -> no -> after_no -> join
+	`)
+	require.NoError(t, err)
+
+	vars := NewVarsFrom(nil)
+	run := NewRun(Spec{}, vars)
+	now := time.Now()
+	run.PipelineTaskRuns = []TaskRun{
+		{DotID: "check", Output: jsonserializable.JSONSerializable{Val: true, Valid: true}, CreatedAt: now, FinishedAt: null.TimeFrom(now)},
+		{DotID: "yes", CreatedAt: now},
+		{DotID: "no", Skipped: true, CreatedAt: now, FinishedAt: null.TimeFrom(now)},
+	}
+	s := newScheduler(p, run, vars, logger.TestLogger(t))
+
+	go s.Run()
+
+	for _, expected := range []string{"yes", "join"} {
+		select {
+		case taskRun := <-s.taskCh:
+			require.Equal(t, expected, taskRun.task.DotID())
+			if expected == "join" {
+				// the input from the skipped branch is marked as skipped
+				require.Equal(t, []Result{{Value: 1}, {Skipped: true}}, taskRun.inputs)
+			}
+			s.report(testutils.Context(t), TaskRunResult{
+				ID:         uuid.New(),
+				Task:       taskRun.task,
+				Result:     Result{Value: 1},
+				FinishedAt: null.TimeFrom(time.Now()),
+				CreatedAt:  time.Now(),
+			})
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for task run")
+		}
+	}
+
+	select {
+	case _, ok := <-s.taskCh:
+		require.Falsef(t, ok, "scheduler has more tasks to schedule")
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for scheduler to halt")
+	}
+
+	require.True(t, s.results[p.ByDotID("after_no").ID()].Result.Skipped)
+	require.False(t, s.results[p.ByDotID("join").ID()].Result.Skipped)
+}
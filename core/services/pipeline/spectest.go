@@ -0,0 +1,304 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"sigs.k8s.io/yaml"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// SpecTestFixture defines the test cases of a pipeline spec, for RunSpecTests.
+type SpecTestFixture struct {
+	Tests []SpecTestCase `json:"tests"`
+}
+
+// SpecTestCase is a single run of a pipeline spec, with mocked tasks and expected results.
+type SpecTestCase struct {
+	Name string `json:"name"`
+	// Vars are the variables the run starts with, e.g. jobSpec and jobRun.
+	Vars map[string]interface{} `json:"vars"`
+	// Mocks maps the DOT ID of each task which calls out to the network, e.g. http, bridge and ethcall
+	// tasks, to its result. Mocked tasks aren't executed, and all such tasks must be mocked.
+	Mocks map[string]SpecTestMock `json:"mocks"`
+	// Outputs, if set, are the expected values of the run's outputs, ordered by output index.
+	Outputs []interface{} `json:"outputs"`
+	// Errors, if set, are the expected errors of the run's outputs, ordered by output index. Each
+	// error must contain the expected one, and an empty string means the output must not have errored.
+	Errors []string `json:"errors"`
+	// Tasks maps the DOT IDs of tasks to their expected values.
+	Tasks map[string]interface{} `json:"tasks"`
+}
+
+// SpecTestMock is the result of a mocked task. Values of http and bridge tasks which aren't strings are
+// encoded as JSON, since those tasks return the response body.
+type SpecTestMock struct {
+	Value interface{} `json:"value"`
+	// Error, if set, is returned instead of the value.
+	Error string `json:"error"`
+}
+
+// SpecTestResult is the outcome of a SpecTestCase.
+type SpecTestResult struct {
+	Name string
+	// Run is the executed run.
+	Run *Run
+	// Failures describes each expectation which wasn't met. The test passed if there are none.
+	Failures []string
+}
+
+// Passed returns true if all the expectations of the test case were met.
+func (r SpecTestResult) Passed() bool {
+	return len(r.Failures) == 0
+}
+
+// ParseSpecTestFixture parses a spec test fixture from YAML or JSON. Numbers are decoded like the
+// jsonparse task does.
+func ParseSpecTestFixture(data string) (SpecTestFixture, error) {
+	var f SpecTestFixture
+	b, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		return f, err
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err = d.Decode(&f); err != nil {
+		return f, err
+	}
+
+	for i := range f.Tests {
+		vars, err := jsonserializable.ReinterpretJSONNumbers(f.Tests[i].Vars)
+		if err != nil {
+			return f, err
+		}
+		f.Tests[i].Vars, _ = vars.(map[string]interface{})
+		for id, mock := range f.Tests[i].Mocks {
+			if mock.Value, err = jsonserializable.ReinterpretJSONNumbers(mock.Value); err != nil {
+				return f, err
+			}
+			f.Tests[i].Mocks[id] = mock
+		}
+	}
+	return f, nil
+}
+
+// RunSpecTests runs each test case of the fixture against the pipeline spec, given as the DOT source of
+// the pipeline, with the real runner. Tasks which call out to the network are replaced by the mocks of
+// each test case, and runs are kept in memory, so no database or chain is needed.
+func RunSpecTests(ctx context.Context, lggr logger.Logger, dotSource string, fixture SpecTestFixture) ([]SpecTestResult, error) {
+	p, err := Parse(dotSource)
+	if err != nil {
+		return nil, err
+	}
+	for _, test := range fixture.Tests {
+		for id := range test.Mocks {
+			task := p.ByDotID(id)
+			if task == nil {
+				return nil, errors.Errorf("test %q: mocked task %s doesn't exist", test.Name, id)
+			} else if !IsReplayedTaskType(task.Type()) {
+				return nil, errors.Errorf("test %q: mocked task %s is a %s task, which can't be mocked", test.Name, id, task.Type())
+			}
+		}
+	}
+
+	r := NewRunner(nil, nil, specTestConfig{}, nil, nil, nil, nil, lggr, nil, nil)
+	var results []SpecTestResult
+	for _, test := range fixture.Tests {
+		spec := Spec{DotDagSource: dotSource}
+		pipeline, err := r.InitializePipeline(spec)
+		if err != nil {
+			return nil, err
+		}
+
+		mocks := make(map[string]Result, len(test.Mocks))
+		for id, mock := range test.Mocks {
+			mocks[id] = mock.result(pipeline.ByDotID(id).Type())
+		}
+
+		vars := NewVarsFrom(copyVars(test.Vars))
+		run := NewRun(spec, vars)
+		r.run(ctx, pipeline, run, vars, lggr, mocks)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		results = append(results, SpecTestResult{Name: test.Name, Run: run, Failures: test.check(run)})
+	}
+	return results, nil
+}
+
+func (m SpecTestMock) result(taskType TaskType) Result {
+	if m.Error != "" {
+		return Result{Error: errors.New(m.Error)}
+	}
+	if _, isString := m.Value.(string); !isString && (taskType == TaskTypeHTTP || taskType == TaskTypeBridge) {
+		b, err := json.Marshal(m.Value)
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "failed to encode mock")}
+		}
+		return Result{Value: string(b)}
+	}
+	return Result{Value: m.Value}
+}
+
+// check returns the expectations of the test case which the run didn't meet.
+func (test SpecTestCase) check(run *Run) (failures []string) {
+	var outputs []interface{}
+	if run.Outputs.Valid {
+		outputs, _ = run.Outputs.Val.([]interface{})
+	}
+
+	if test.Outputs != nil {
+		if len(outputs) != len(test.Outputs) {
+			failures = append(failures, fmt.Sprintf("expected %d outputs, got %d", len(test.Outputs), len(outputs)))
+		} else {
+			for i := range outputs {
+				if !specTestValuesMatch(test.Outputs[i], outputs[i]) {
+					failures = append(failures, fmt.Sprintf("output %d: expected %s, got %s", i, specTestJSON(test.Outputs[i]), specTestJSON(outputs[i])))
+				}
+			}
+		}
+	}
+
+	if test.Errors != nil {
+		if len(run.FatalErrors) != len(test.Errors) {
+			failures = append(failures, fmt.Sprintf("expected %d output errors, got %d", len(test.Errors), len(run.FatalErrors)))
+		} else {
+			for i, expected := range test.Errors {
+				actual := run.FatalErrors[i]
+				if expected == "" && actual.Valid {
+					failures = append(failures, fmt.Sprintf("output %d: expected no error, got %q", i, actual.String))
+				} else if expected != "" && !strings.Contains(actual.String, expected) {
+					failures = append(failures, fmt.Sprintf("output %d: expected error containing %q, got %q", i, expected, actual.String))
+				}
+			}
+		}
+	} else if test.Outputs != nil && run.HasFatalErrors() {
+		// expecting outputs implies expecting no errors
+		for i, err := range run.FatalErrors {
+			if err.Valid {
+				failures = append(failures, fmt.Sprintf("output %d: unexpected error %q", i, err.String))
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(test.Tasks))
+	for id := range test.Tasks {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		taskRun := run.ByDotID(id)
+		if taskRun == nil {
+			failures = append(failures, fmt.Sprintf("task %s: didn't run", id))
+			continue
+		}
+		if taskRun.Error.Valid {
+			failures = append(failures, fmt.Sprintf("task %s: expected %s, got error %q", id, specTestJSON(test.Tasks[id]), taskRun.Error.String))
+		} else if !specTestValuesMatch(test.Tasks[id], taskRun.Output.Val) {
+			failures = append(failures, fmt.Sprintf("task %s: expected %s, got %s", id, specTestJSON(test.Tasks[id]), specTestJSON(taskRun.Output.Val)))
+		}
+	}
+	return failures
+}
+
+// specTestValuesMatch compares an expected value from a fixture with a value returned by a task, after
+// encoding the latter as JSON. Numbers and strings holding numbers match if they're numerically equal.
+func specTestValuesMatch(expected, actual interface{}) bool {
+	b, err := json.Marshal(actual)
+	if err != nil {
+		return false
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var decoded interface{}
+	if err = d.Decode(&decoded); err != nil {
+		return false
+	}
+	return specTestJSONValuesMatch(expected, decoded)
+}
+
+func specTestJSONValuesMatch(expected, actual interface{}) bool {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok || len(a) != len(e) {
+			return false
+		}
+		for k, v := range e {
+			if av, exists := a[k]; !exists || !specTestJSONValuesMatch(v, av) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(e) {
+			return false
+		}
+		for i := range e {
+			if !specTestJSONValuesMatch(e[i], a[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number, string:
+		ed, err1 := decimal.NewFromString(fmt.Sprint(e))
+		ad, err2 := decimal.NewFromString(fmt.Sprint(actual))
+		if err1 == nil && err2 == nil {
+			if _, isBool := actual.(bool); !isBool {
+				return ed.Equal(ad)
+			}
+		}
+	}
+	return reflect.DeepEqual(expected, actual)
+}
+
+func specTestJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// copyVars copies the top level of vars, since runs add the results of their tasks to them.
+func copyVars(vars map[string]interface{}) map[string]interface{} {
+	c := make(map[string]interface{}, len(vars))
+	for k, v := range vars {
+		c[k] = v
+	}
+	return c
+}
+
+// specTestConfig configures the runner of spec tests. Runs are only bound by their context.
+type specTestConfig struct{}
+
+var _ Config = specTestConfig{}
+
+func (specTestConfig) DefaultHTTPLimit() int64 { return 0 }
+func (specTestConfig) DefaultHTTPTimeout() commonconfig.Duration {
+	return *commonconfig.MustNewDuration(15 * time.Second)
+}
+func (specTestConfig) MaxRunDuration() time.Duration  { return 0 }
+func (specTestConfig) ReaperInterval() time.Duration  { return 0 }
+func (specTestConfig) ReaperThreshold() time.Duration { return 0 }
+func (specTestConfig) VerboseLogging() bool           { return false }
+func (specTestConfig) HTTPHostLimits() map[string]coreconfig.JobPipelineRequestLimit {
+	return nil
+}
+func (specTestConfig) HTTPBridgeLimits() map[string]coreconfig.JobPipelineRequestLimit {
+	return nil
+}
@@ -0,0 +1,116 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const specTestSpec = `
+ds1       [type=http method=GET url="https://example.com/eth-usd"];
+ds1_parse [type=jsonparse path="data,price"];
+ds2       [type=bridge name=coingecko requestData=<{"from": "ETH", "to": "USD"}>];
+ds2_parse [type=jsonparse path="result"];
+median    [type=median allowedFaults=1];
+scale     [type=multiply times="$(jobRun.meta.scale)"];
+
+ds1 -> ds1_parse -> median;
+ds2 -> ds2_parse -> median;
+median -> scale;
+`
+
+const specTestFixture = `
+tests:
+  - name: median of both sources
+    vars:
+      jobRun:
+        meta:
+          scale: 100
+    mocks:
+      ds1:
+        value:
+          data:
+            price: 3000.5
+      ds2:
+        value: '{"result": "3001.5"}'
+    outputs: [300100]
+    tasks:
+      median: "3001"
+  - name: one source failing
+    vars:
+      jobRun:
+        meta:
+          scale: 1
+    mocks:
+      ds1:
+        error: connection refused
+      ds2:
+        value: {result: 3001.5}
+    outputs: ["3001.5"]
+  - name: wrong expectations
+    vars:
+      jobRun:
+        meta:
+          scale: 1
+    mocks:
+      ds1:
+        value: {data: {price: 1}}
+      ds2:
+        value: {result: 2}
+    outputs: [2]
+    tasks:
+      ds1_parse: 2
+  - name: both sources failing
+    mocks:
+      ds1:
+        error: connection refused
+    errors: ["too many errors"]
+`
+
+func TestRunSpecTests(t *testing.T) {
+	t.Parallel()
+
+	fixture, err := pipeline.ParseSpecTestFixture(specTestFixture)
+	require.NoError(t, err)
+	require.Len(t, fixture.Tests, 4)
+
+	results, err := pipeline.RunSpecTests(testutils.Context(t), logger.TestLogger(t), specTestSpec, fixture)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	assert.Equal(t, "median of both sources", results[0].Name)
+	assert.True(t, results[0].Passed(), results[0].Failures)
+	assert.True(t, results[1].Passed(), results[1].Failures)
+	assert.Equal(t, []string{
+		`output 0: expected 2, got "1.5"`,
+		`task ds1_parse: expected 2, got 1`,
+	}, results[2].Failures)
+	// the unmocked bridge task fails, as does the median
+	assert.True(t, results[3].Passed(), results[3].Failures)
+	assert.Contains(t, results[3].Run.ByDotID("ds2").Error.String, "no result was recorded for task ds2")
+}
+
+func TestRunSpecTests_InvalidMocks(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		fixture string
+		err     string
+	}{
+		{"unknown task", `tests: [{name: a, mocks: {ds3: {value: 1}}}]`, `test "a": mocked task ds3 doesn't exist`},
+		{"task which can't be mocked", `tests: [{name: a, mocks: {median: {value: 1}}}]`, `test "a": mocked task median is a median task, which can't be mocked`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			fixture, err := pipeline.ParseSpecTestFixture(test.fixture)
+			require.NoError(t, err)
+			_, err = pipeline.RunSpecTests(testutils.Context(t), logger.TestLogger(t), specTestSpec, fixture)
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
@@ -69,6 +69,7 @@ type BridgeTask struct {
 	config       Config
 	bridgeConfig BridgeConfig
 	httpClient   *http.Client
+	limiter      *httpRequestLimiter
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -167,7 +168,17 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	}
 
 	var cachedResponse bool
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	var (
+		responseBytes []byte
+		statusCode    int
+		headers       http.Header
+		elapsed       time.Duration
+	)
+	release, err := t.limiter.acquire(requestCtx, url.Hostname(), string(name))
+	if err == nil {
+		responseBytes, statusCode, headers, elapsed, err = makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+		release()
+	}
 
 	// check for external adapter response object status
 	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
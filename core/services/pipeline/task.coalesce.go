@@ -0,0 +1,43 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// CoalesceTask joins the branches of an IfTask or SwitchTask. It returns the result of its first input
+// which wasn't skipped, and is only skipped itself if all of its inputs were.
+//
+//	large_payment -> payment_result
+//	small_payment -> payment_result
+//	payment_result [type="coalesce"]
+//
+// Return types:
+//
+//	the type of the input
+type CoalesceTask struct {
+	BaseTask `mapstructure:",squash"`
+}
+
+var (
+	_ Task     = (*CoalesceTask)(nil)
+	_ joinTask = (*CoalesceTask)(nil)
+)
+
+func (t *CoalesceTask) Type() TaskType {
+	return TaskTypeCoalesce
+}
+
+func (t *CoalesceTask) isJoin() {}
+
+func (t *CoalesceTask) Run(_ context.Context, _ logger.Logger, _ Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	for _, input := range inputs {
+		if !input.Skipped {
+			return Result{Value: input.Value, Error: input.Error}, runInfo
+		}
+	}
+	return Result{Error: errors.Wrap(ErrWrongInputCardinality, "no inputs which weren't skipped")}, runInfo
+}
@@ -0,0 +1,47 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestCoalesceTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		inputs  []pipeline.Result
+		want    pipeline.Result
+		wantErr error
+	}{
+		{"first input", []pipeline.Result{{Value: 1}, {Value: 2}}, pipeline.Result{Value: 1}, nil},
+		{"first input which wasn't skipped", []pipeline.Result{{Skipped: true}, {Value: 2}}, pipeline.Result{Value: 2}, nil},
+		{"errored input", []pipeline.Result{{Skipped: true}, {Error: pipeline.ErrBadInput}, {Value: 3}}, pipeline.Result{Error: pipeline.ErrBadInput}, nil},
+		{"all inputs skipped", []pipeline.Result{{Skipped: true}}, pipeline.Result{}, pipeline.ErrWrongInputCardinality},
+		{"no inputs", nil, pipeline.Result{}, pipeline.ErrWrongInputCardinality},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.CoalesceTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0)}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErr != nil {
+				require.ErrorIs(t, result.Error, test.wantErr)
+				return
+			}
+			require.Equal(t, test.want, result)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ETHGetBlockTask reads the header of a block, given by number in decimal or hex. `block` defaults to the latest block.
+//
+//	block [type="ethgetblock" block="$(jobRun.logBlockNumber)"]
+//
+// Return types:
+//
+//	map[string]interface{} with the keys:
+//	  number:    int64
+//	  hash:      common.Hash
+//	  timestamp: int64, in seconds since the epoch
+//	  baseFee:   *big.Int, or nil before EIP-1559
+type ETHGetBlockTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Block      string `json:"block"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	legacyChains legacyevm.LegacyChainContainer
+}
+
+var _ Task = (*ETHGetBlockTask)(nil)
+
+func (t *ETHGetBlockTask) Type() TaskType {
+	return TaskTypeETHGetBlock
+}
+
+func (t *ETHGetBlockTask) getEvmChainID() string {
+	if t.EVMChainID == "" {
+		t.EVMChainID = "$(jobSpec.evmChainID)"
+	}
+	return t.EVMChainID
+}
+
+func (t *ETHGetBlockTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		block   StringParam
+		chainID StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&block, From(VarExpr(t.Block, vars), t.Block)), "block"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	blockNumber, err := parseBlockNumber(string(block))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "block")}, runInfo
+	}
+
+	chain, err := t.legacyChains.Get(string(chainID))
+	if err != nil {
+		err = fmt.Errorf("%w: %s: %w", ErrInvalidEVMChainID, chainID, err)
+		return Result{Error: err}, runInfo
+	}
+
+	head, err := chain.Client().HeadByNumber(ctx, blockNumber)
+	if err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	} else if head == nil {
+		return Result{Error: errors.Errorf("block %s not found", block)}, retryableRunInfo()
+	}
+
+	var baseFee *big.Int
+	if head.BaseFeePerGas != nil {
+		baseFee = head.BaseFeePerGas.ToInt()
+	}
+	return Result{Value: map[string]interface{}{
+		"number":    head.Number,
+		"hash":      head.Hash,
+		"timestamp": head.Timestamp.Unix(),
+		"baseFee":   baseFee,
+	}}, runInfo
+}
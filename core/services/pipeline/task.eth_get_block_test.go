@@ -0,0 +1,106 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestETHGetBlockTask(t *testing.T) {
+	t.Parallel()
+
+	hash := common.HexToHash("0xb1")
+	timestamp := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name               string
+		block              string
+		setupClientMocks   func(ethClient *evmclimocks.Client)
+		expected           interface{}
+		expectedErrorCause error
+		expectedRetryable  bool
+	}{
+		{
+			"latest block",
+			"",
+			func(ethClient *evmclimocks.Client) {
+				ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).
+					Return(&evmtypes.Head{Number: 100, Hash: hash, Timestamp: timestamp, BaseFeePerGas: assets.NewWeiI(7)}, nil)
+			},
+			map[string]interface{}{"number": int64(100), "hash": hash, "timestamp": int64(1700000000), "baseFee": big.NewInt(7)},
+			nil,
+			false,
+		},
+		{
+			"block by number without base fee",
+			"0x5",
+			func(ethClient *evmclimocks.Client) {
+				ethClient.On("HeadByNumber", mock.Anything, big.NewInt(5)).
+					Return(&evmtypes.Head{Number: 5, Hash: hash, Timestamp: timestamp}, nil)
+			},
+			map[string]interface{}{"number": int64(5), "hash": hash, "timestamp": int64(1700000000), "baseFee": (*big.Int)(nil)},
+			nil,
+			false,
+		},
+		{
+			"block not found",
+			"5",
+			func(ethClient *evmclimocks.Client) {
+				ethClient.On("HeadByNumber", mock.Anything, big.NewInt(5)).Return(nil, nil)
+			},
+			nil,
+			nil,
+			true,
+		},
+		{
+			"invalid block",
+			"-1",
+			func(ethClient *evmclimocks.Client) {},
+			nil,
+			pipeline.ErrBadInput,
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ETHGetBlockTask{
+				BaseTask:   pipeline.NewBaseTask(0, "ethgetblock", nil, nil, 0),
+				Block:      test.block,
+				EVMChainID: "0",
+			}
+
+			ethClient := evmclimocks.NewClient(t)
+			test.setupClientMocks(ethClient)
+			task.HelperSetDependencies(newLegacyChainsWithLogPoller(t, ethClient, nil))
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			assert.False(t, runInfo.IsPending)
+			assert.Equal(t, test.expectedRetryable, runInfo.IsRetryable)
+			if test.expectedErrorCause != nil || test.expectedRetryable {
+				require.Error(t, result.Error)
+				if test.expectedErrorCause != nil {
+					require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				}
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.expected, result.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ETHGetLogsTask reads the logs emitted by a contract in a range of blocks. `topics` lists the topics which
+// the logs must have, starting with the event signature. `toBlock` defaults to the latest block, and `fromBlock`
+// defaults to `lookback` blocks before `toBlock`, or to `toBlock` itself.
+//
+// If a log poller filter of the chain covers the contract and event signature, and the log poller has the logs
+// of the whole range, i.e. it processed the range with the filter and the filter's retention doesn't prune any
+// of its logs, the logs are read from the log poller's database. Otherwise they're read from the chain's RPC
+// node, for ranges of at most 10000 blocks.
+//
+//	logs [type="ethgetlogs" address="0x..." topics=<["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"]> lookback="100"]
+//
+// Return types:
+//
+//	[]interface{} of map[string]interface{} with the keys:
+//	  address:         common.Address
+//	  topics:          []common.Hash
+//	  data:            []byte
+//	  blockNumber:     int64
+//	  blockHash:       common.Hash
+//	  transactionHash: common.Hash
+//	  logIndex:        int64
+type ETHGetLogsTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Address    string `json:"address"`
+	Topics     string `json:"topics"`
+	FromBlock  string `json:"fromBlock"`
+	ToBlock    string `json:"toBlock"`
+	Lookback   string `json:"lookback"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	legacyChains legacyevm.LegacyChainContainer
+}
+
+var _ Task = (*ETHGetLogsTask)(nil)
+
+// maxETHGetLogsClientBlockRange bounds the range of blocks read from an RPC node, which would otherwise time
+// out or be rejected by the node.
+const maxETHGetLogsClientBlockRange = 10_000
+
+func (t *ETHGetLogsTask) Type() TaskType {
+	return TaskTypeETHGetLogs
+}
+
+func (t *ETHGetLogsTask) getEvmChainID() string {
+	if t.EVMChainID == "" {
+		t.EVMChainID = "$(jobSpec.evmChainID)"
+	}
+	return t.EVMChainID
+}
+
+func (t *ETHGetLogsTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		address   AddressParam
+		topics    HashSliceParam
+		fromBlock StringParam
+		toBlock   StringParam
+		lookback  Uint64Param
+		chainID   StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&address, From(VarExpr(t.Address, vars), NonemptyString(t.Address))), "address"),
+		errors.Wrap(ResolveParam(&topics, From(VarExpr(t.Topics, vars), JSONWithVarExprs(t.Topics, vars, false), nil)), "topics"),
+		errors.Wrap(ResolveParam(&fromBlock, From(VarExpr(t.FromBlock, vars), t.FromBlock)), "fromBlock"),
+		errors.Wrap(ResolveParam(&toBlock, From(VarExpr(t.ToBlock, vars), t.ToBlock)), "toBlock"),
+		errors.Wrap(ResolveParam(&lookback, From(VarExpr(t.Lookback, vars), NonemptyString(t.Lookback), 0)), "lookback"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	from, err := parseBlockNumber(string(fromBlock))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "fromBlock")}, runInfo
+	}
+	to, err := parseBlockNumber(string(toBlock))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "toBlock")}, runInfo
+	}
+
+	chain, err := t.legacyChains.Get(string(chainID))
+	if err != nil {
+		err = fmt.Errorf("%w: %s: %w", ErrInvalidEVMChainID, chainID, err)
+		return Result{Error: err}, runInfo
+	}
+
+	var logs []interface{}
+	var found bool
+	if lp := chain.LogPoller(); lp != nil && len(topics) > 0 {
+		logs, found, err = t.logPollerLogs(ctx, lp, common.Address(address), topics, from, to, uint64(lookback))
+	}
+	if err == nil && !found {
+		logs, err = t.clientLogs(ctx, chain, common.Address(address), topics, from, to, uint64(lookback))
+	}
+	if errors.Is(err, ErrBadInput) {
+		return Result{Error: err}, runInfo
+	} else if err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	}
+	return Result{Value: logs}, runInfo
+}
+
+// blockRange returns the range of blocks to read, given the latest block.
+func (t *ETHGetLogsTask) blockRange(from, to *big.Int, lookback uint64, latest int64) (int64, int64, error) {
+	end := latest
+	if to != nil {
+		end = to.Int64()
+	}
+	start := end - int64(lookback)
+	if from != nil {
+		start = from.Int64()
+	}
+	if start < 0 {
+		start = 0
+	}
+	if start > end {
+		return 0, 0, errors.Wrapf(ErrBadInput, "fromBlock %d is after toBlock %d", start, end)
+	}
+	return start, end, nil
+}
+
+// logPollerLogs reads the logs from the log poller. It returns false if the log poller doesn't have all the logs
+// of the range, which must then be read from the chain instead.
+func (t *ETHGetLogsTask) logPollerLogs(ctx context.Context, lp logpoller.LogPoller, address common.Address, topics []common.Hash, from, to *big.Int, lookback uint64) ([]interface{}, bool, error) {
+	filters := logPollerFilters(lp, address, topics[0])
+	if len(filters) == 0 {
+		return nil, false, nil
+	}
+	latest, err := lp.LatestBlock(ctx)
+	if err != nil {
+		// e.g. the log poller hasn't processed any block yet
+		return nil, false, nil
+	}
+	start, end, err := t.blockRange(from, to, lookback, latest.BlockNumber)
+	if err != nil {
+		return nil, false, err
+	}
+	if end > latest.BlockNumber {
+		return nil, false, nil
+	}
+	retained, err := logPollerHasLogs(ctx, lp, filters, address, topics[0], start)
+	if err != nil || !retained {
+		return nil, false, err
+	}
+
+	lpLogs, err := lp.Logs(ctx, start, end, topics[0], address)
+	if err != nil {
+		return nil, false, err
+	}
+
+	logs := []interface{}{}
+	for _, l := range lpLogs {
+		logTopics := l.GetTopics()
+		if !logHasTopics(logTopics, topics) {
+			continue
+		}
+		logs = append(logs, map[string]interface{}{
+			"address":         l.Address,
+			"topics":          logTopics,
+			"data":            l.Data,
+			"blockNumber":     l.BlockNumber,
+			"blockHash":       l.BlockHash,
+			"transactionHash": l.TxHash,
+			"logIndex":        l.LogIndex,
+		})
+	}
+	return logs, true, nil
+}
+
+func (t *ETHGetLogsTask) clientLogs(ctx context.Context, chain legacyevm.Chain, address common.Address, topics []common.Hash, from, to *big.Int, lookback uint64) ([]interface{}, error) {
+	var latest int64
+	if to == nil {
+		height, err := chain.Client().LatestBlockHeight(ctx)
+		if err != nil {
+			return nil, err
+		}
+		latest = height.Int64()
+	}
+	start, end, err := t.blockRange(from, to, lookback, latest)
+	if err != nil {
+		return nil, err
+	}
+	if end-start+1 > maxETHGetLogsClientBlockRange {
+		return nil, errors.Wrapf(ErrBadInput, "range of %d blocks exceeds the maximum of %d", end-start+1, maxETHGetLogsClientBlockRange)
+	}
+
+	query := ethereum.FilterQuery{
+		FromBlock: big.NewInt(start),
+		ToBlock:   big.NewInt(end),
+		Addresses: []common.Address{address},
+	}
+	for _, topic := range topics {
+		query.Topics = append(query.Topics, []common.Hash{topic})
+	}
+
+	clientLogs, err := chain.Client().FilterLogs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []interface{}{}
+	for _, l := range clientLogs {
+		logs = append(logs, ethLogToMap(l))
+	}
+	return logs, nil
+}
+
+func ethLogToMap(l types.Log) map[string]interface{} {
+	return map[string]interface{}{
+		"address":         l.Address,
+		"topics":          l.Topics,
+		"data":            l.Data,
+		"blockNumber":     int64(l.BlockNumber),
+		"blockHash":       l.BlockHash,
+		"transactionHash": l.TxHash,
+		"logIndex":        int64(l.Index),
+	}
+}
+
+// logPollerFilters returns the filters registered with the log poller which cover all the logs of the event of
+// the contract, i.e. which don't restrict its other topics.
+func logPollerFilters(lp logpoller.LogPoller, address common.Address, eventSig common.Hash) []logpoller.Filter {
+	var filters []logpoller.Filter
+	for _, filter := range lp.GetFilters() {
+		if len(filter.Topic2) > 0 || len(filter.Topic3) > 0 || len(filter.Topic4) > 0 {
+			continue
+		}
+		if slices.Contains(filter.Addresses, address) && slices.Contains(filter.EventSigs, eventSig) {
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
+
+// logPollerHasLogs returns true if one of the filters has all the logs of the event from the start block on. The
+// log poller doesn't backfill the logs of the blocks it processed before the event was added to a filter. Logs
+// are kept forever by filters without a retention, and filters limiting the number of logs may have pruned any
+// of them.
+func logPollerHasLogs(ctx context.Context, lp logpoller.LogPoller, filters []logpoller.Filter, address common.Address, eventSig common.Hash, start int64) (bool, error) {
+	blocks, err := lp.GetBlocksRange(ctx, []uint64{uint64(start)})
+	if err != nil {
+		return false, err
+	}
+	startTime := blocks[0].BlockTimestamp
+	for _, filter := range filters {
+		if filter.MaxLogsKept > 0 {
+			continue
+		}
+		createdAt, err := lp.FilterCreatedAt(ctx, filter.Name, address, eventSig)
+		if err != nil {
+			return false, err
+		}
+		if startTime.Before(createdAt) {
+			continue
+		}
+		if filter.Retention == 0 || time.Since(startTime) < filter.Retention {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// logHasTopics returns true if the log starts with the given topics.
+func logHasTopics(logTopics, topics []common.Hash) bool {
+	if len(logTopics) < len(topics) {
+		return false
+	}
+	for i, topic := range topics {
+		if logTopics[i] != topic {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,300 @@
+package pipeline_test
+
+import (
+	"database/sql"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// newLegacyChainsWithLogPoller returns the chain with ID 0, which uses the given client and log poller.
+func newLegacyChainsWithLogPoller(t *testing.T, ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) legacyevm.LegacyChainContainer {
+	cfg := configtest.NewGeneralConfig(t, nil)
+	ch := new(evmmocks.Chain)
+	ch.On("Client").Return(ethClient)
+	ch.On("LogPoller").Return(lp)
+	ch.On("ID").Return(evmtest.NewChainScopedConfig(t, cfg).EVM().ChainID())
+	return cltest.NewLegacyChainsWithChain(ch, cfg)
+}
+
+func TestETHGetLogsTask(t *testing.T) {
+	t.Parallel()
+
+	address := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	eventSig := common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	topic := common.HexToHash("0x01")
+	otherTopic := common.HexToHash("0x02")
+	blockHash := common.HexToHash("0xb1")
+	txHash := common.HexToHash("0xc1")
+
+	expectedLog := map[string]interface{}{
+		"address":         address,
+		"topics":          []common.Hash{eventSig, topic},
+		"data":            []byte{1, 2, 3},
+		"blockNumber":     int64(95),
+		"blockHash":       blockHash,
+		"transactionHash": txHash,
+		"logIndex":        int64(2),
+	}
+	clientLog := types.Log{
+		Address:     address,
+		Topics:      []common.Hash{eventSig, topic},
+		Data:        []byte{1, 2, 3},
+		BlockNumber: 95,
+		BlockHash:   blockHash,
+		TxHash:      txHash,
+		Index:       2,
+	}
+	lpLog := func(topics ...common.Hash) logpoller.Log {
+		var raw pq.ByteaArray
+		for _, topic := range topics {
+			raw = append(raw, topic.Bytes())
+		}
+		return logpoller.Log{Address: address, Topics: raw, EventSig: eventSig, Data: []byte{1, 2, 3}, BlockNumber: 95, BlockHash: blockHash, TxHash: txHash, LogIndex: 2}
+	}
+	filter := logpoller.Filter{Name: "filter", Addresses: []common.Address{address}, EventSigs: []common.Hash{eventSig}}
+
+	tests := []struct {
+		name               string
+		topics             string
+		fromBlock          string
+		toBlock            string
+		lookback           string
+		setupMocks         func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller)
+		expected           interface{}
+		expectedErrorCause error
+	}{
+		{
+			"client with lookback from the latest block",
+			`["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", "0x0000000000000000000000000000000000000000000000000000000000000001"]`,
+			"", "", "10",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				lp.On("GetFilters").Return(nil)
+				ethClient.On("LatestBlockHeight", mock.Anything).Return(big.NewInt(100), nil)
+				ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+					FromBlock: big.NewInt(90),
+					ToBlock:   big.NewInt(100),
+					Addresses: []common.Address{address},
+					Topics:    [][]common.Hash{{eventSig}, {topic}},
+				}).Return([]types.Log{clientLog}, nil)
+			},
+			[]interface{}{expectedLog},
+			nil,
+		},
+		{
+			"client with block range",
+			"",
+			"0x5a", "96", "",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+					FromBlock: big.NewInt(90),
+					ToBlock:   big.NewInt(96),
+					Addresses: []common.Address{address},
+				}).Return([]types.Log{clientLog}, nil)
+			},
+			[]interface{}{expectedLog},
+			nil,
+		},
+		{
+			"log poller with a filter for the event",
+			`["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", "0x0000000000000000000000000000000000000000000000000000000000000001"]`,
+			"", "", "10",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				lp.On("GetFilters").Return(map[string]logpoller.Filter{"filter": filter})
+				lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 100}, nil)
+				lp.On("GetBlocksRange", mock.Anything, []uint64{90}).Return([]logpoller.LogPollerBlock{{BlockNumber: 90, BlockTimestamp: time.Now().Add(-time.Minute)}}, nil)
+				lp.On("FilterCreatedAt", mock.Anything, "filter", address, eventSig).Return(time.Now().Add(-time.Hour), nil)
+				lp.On("Logs", mock.Anything, int64(90), int64(100), eventSig, address).
+					Return([]logpoller.Log{lpLog(eventSig, topic), lpLog(eventSig, otherTopic)}, nil)
+			},
+			[]interface{}{expectedLog},
+			nil,
+		},
+		{
+			"log poller which hasn't processed any block",
+			`["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"]`,
+			"90", "100", "",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				lp.On("GetFilters").Return(map[string]logpoller.Filter{"filter": filter})
+				lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{}, sql.ErrNoRows)
+				ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+					FromBlock: big.NewInt(90),
+					ToBlock:   big.NewInt(100),
+					Addresses: []common.Address{address},
+					Topics:    [][]common.Hash{{eventSig}},
+				}).Return([]types.Log{clientLog}, nil)
+			},
+			[]interface{}{expectedLog},
+			nil,
+		},
+		{
+			"log poller with a filter created after the start of the range",
+			`["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"]`,
+			"90", "100", "",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				lp.On("GetFilters").Return(map[string]logpoller.Filter{"filter": filter})
+				lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 100}, nil)
+				lp.On("GetBlocksRange", mock.Anything, []uint64{90}).Return([]logpoller.LogPollerBlock{{BlockNumber: 90, BlockTimestamp: time.Now().Add(-time.Hour)}}, nil)
+				lp.On("FilterCreatedAt", mock.Anything, "filter", address, eventSig).Return(time.Now().Add(-time.Minute), nil)
+				ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+					FromBlock: big.NewInt(90),
+					ToBlock:   big.NewInt(100),
+					Addresses: []common.Address{address},
+					Topics:    [][]common.Hash{{eventSig}},
+				}).Return([]types.Log{clientLog}, nil)
+			},
+			[]interface{}{expectedLog},
+			nil,
+		},
+		{
+			"log poller with a filter restricting the topics",
+			`["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"]`,
+			"90", "100", "",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				restricting := filter
+				restricting.Topic2 = []common.Hash{topic}
+				lp.On("GetFilters").Return(map[string]logpoller.Filter{"filter": restricting})
+				ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+					FromBlock: big.NewInt(90),
+					ToBlock:   big.NewInt(100),
+					Addresses: []common.Address{address},
+					Topics:    [][]common.Hash{{eventSig}},
+				}).Return([]types.Log{clientLog}, nil)
+			},
+			[]interface{}{expectedLog},
+			nil,
+		},
+		{
+			"log poller which hasn't processed the range",
+			`["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"]`,
+			"95", "105", "",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				lp.On("GetFilters").Return(map[string]logpoller.Filter{"filter": filter})
+				lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 100}, nil)
+				ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+					FromBlock: big.NewInt(95),
+					ToBlock:   big.NewInt(105),
+					Addresses: []common.Address{address},
+					Topics:    [][]common.Hash{{eventSig}},
+				}).Return([]types.Log{clientLog}, nil)
+			},
+			[]interface{}{expectedLog},
+			nil,
+		},
+		{
+			"log poller with a filter retaining the range",
+			`["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"]`,
+			"90", "", "",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				retaining := filter
+				retaining.Retention = time.Hour
+				lp.On("GetFilters").Return(map[string]logpoller.Filter{"filter": retaining})
+				lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 100}, nil)
+				lp.On("GetBlocksRange", mock.Anything, []uint64{90}).Return([]logpoller.LogPollerBlock{{BlockNumber: 90, BlockTimestamp: time.Now().Add(-time.Minute)}}, nil)
+				lp.On("FilterCreatedAt", mock.Anything, "filter", address, eventSig).Return(time.Now().Add(-2*time.Hour), nil)
+				lp.On("Logs", mock.Anything, int64(90), int64(100), eventSig, address).Return([]logpoller.Log{lpLog(eventSig, topic)}, nil)
+			},
+			[]interface{}{expectedLog},
+			nil,
+		},
+		{
+			"log poller with filters which pruned logs of the range",
+			`["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"]`,
+			"90", "", "",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {
+				retaining, limited := filter, filter
+				retaining.Retention = time.Hour
+				limited.Name, limited.MaxLogsKept = "limited", 100
+				lp.On("GetFilters").Return(map[string]logpoller.Filter{"filter": retaining, "limited": limited})
+				lp.On("LatestBlock", mock.Anything).Return(logpoller.LogPollerBlock{BlockNumber: 100}, nil)
+				lp.On("GetBlocksRange", mock.Anything, []uint64{90}).Return([]logpoller.LogPollerBlock{{BlockNumber: 90, BlockTimestamp: time.Now().Add(-2 * time.Hour)}}, nil)
+				lp.On("FilterCreatedAt", mock.Anything, "filter", address, eventSig).Return(time.Now().Add(-3*time.Hour), nil)
+				ethClient.On("LatestBlockHeight", mock.Anything).Return(big.NewInt(100), nil)
+				ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+					FromBlock: big.NewInt(90),
+					ToBlock:   big.NewInt(100),
+					Addresses: []common.Address{address},
+					Topics:    [][]common.Hash{{eventSig}},
+				}).Return([]types.Log{clientLog}, nil)
+			},
+			[]interface{}{expectedLog},
+			nil,
+		},
+		{
+			"client with too many blocks",
+			"",
+			"0", "10000", "",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {},
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"fromBlock after toBlock",
+			"",
+			"100", "90", "",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {},
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"invalid block",
+			"",
+			"", "pending", "",
+			func(ethClient *evmclimocks.Client, lp *lpmocks.LogPoller) {},
+			nil,
+			pipeline.ErrBadInput,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ETHGetLogsTask{
+				BaseTask:   pipeline.NewBaseTask(0, "ethgetlogs", nil, nil, 0),
+				Address:    address.Hex(),
+				Topics:     test.topics,
+				FromBlock:  test.fromBlock,
+				ToBlock:    test.toBlock,
+				Lookback:   test.lookback,
+				EVMChainID: "0",
+			}
+
+			ethClient := evmclimocks.NewClient(t)
+			lp := lpmocks.NewLogPoller(t)
+			test.setupMocks(ethClient, lp)
+			task.HelperSetDependencies(newLegacyChainsWithLogPoller(t, ethClient, lp))
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.expectedErrorCause != nil {
+				require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.expected, result.Value)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package pipeline
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
 	"reflect"
 	"strconv"
@@ -16,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/hex"
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -38,6 +40,13 @@ type ETHTxTask struct {
 	FailOnRevert    string `json:"failOnRevert"`
 	EVMChainID      string `json:"evmChainID" mapstructure:"evmChainID"`
 	TransmitChecker string `json:"transmitChecker"`
+	// Priority, if set, orders the tx in the unstarted queue of its from address: txs with a higher priority
+	// are broadcast first
+	Priority string `json:"priority"`
+	// MaxGasPrice, if set, caps the gas price of the tx in wei, below the max gas price of the chain
+	MaxGasPrice string `json:"maxGasPrice"`
+	// GasBumpPercent, if set, is the minimum percentage by which the gas price of the tx is bumped
+	GasBumpPercent string `json:"gasBumpPercent"`
 
 	forwardingAllowed bool
 	specGasLimit      *uint32
@@ -94,6 +103,9 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		maybeMinConfirmations MaybeUint64Param
 		transmitCheckerMap    MapParam
 		failOnRevert          BoolParam
+		maybePriority         MaybeInt32Param
+		maybeMaxGasPrice      MaybeBigIntParam
+		maybeGasBumpPercent   MaybeUint64Param
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -104,10 +116,17 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(VarExpr(t.MinConfirmations, vars), NonemptyString(t.MinConfirmations), "")), "minConfirmations"),
 		errors.Wrap(ResolveParam(&transmitCheckerMap, From(VarExpr(t.TransmitChecker, vars), JSONWithVarExprs(t.TransmitChecker, vars, false), MapParam{})), "transmitChecker"),
 		errors.Wrap(ResolveParam(&failOnRevert, From(NonemptyString(t.FailOnRevert), false)), "failOnRevert"),
+		errors.Wrap(ResolveParam(&maybePriority, From(VarExpr(t.Priority, vars), NonemptyString(t.Priority), "")), "priority"),
+		errors.Wrap(ResolveParam(&maybeMaxGasPrice, From(VarExpr(t.MaxGasPrice, vars), NonemptyString(t.MaxGasPrice), "")), "maxGasPrice"),
+		errors.Wrap(ResolveParam(&maybeGasBumpPercent, From(VarExpr(t.GasBumpPercent, vars), NonemptyString(t.GasBumpPercent), "")), "gasBumpPercent"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	feePolicy, err := decodeFeePolicy(maybeMaxGasPrice, maybeGasBumpPercent)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
 	var minOutgoingConfirmations uint64
 	if min, isSet := maybeMinConfirmations.Uint64(); isSet {
 		minOutgoingConfirmations = min
@@ -156,6 +175,10 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		Strategy:         strategy,
 		Checker:          transmitChecker,
 		SignalCallback:   true,
+		FeePolicy:        feePolicy,
+	}
+	if priority, isSet := maybePriority.Int32(); isSet {
+		txRequest.Priority = priority
 	}
 
 	if minOutgoingConfirmations > 0 {
@@ -176,6 +199,28 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 	return Result{Value: nil}, runInfo
 }
 
+// decodeFeePolicy returns the fee policy of the tx, or nil if neither maxGasPrice nor gasBumpPercent is set
+func decodeFeePolicy(maybeMaxGasPrice MaybeBigIntParam, maybeGasBumpPercent MaybeUint64Param) (*txmgrtypes.TxFeePolicy, error) {
+	var feePolicy txmgrtypes.TxFeePolicy
+	if maxGasPrice := maybeMaxGasPrice.BigInt(); maxGasPrice != nil {
+		if maxGasPrice.Sign() <= 0 {
+			return nil, errors.Wrapf(ErrBadInput, "maxGasPrice must be positive, got %s", maxGasPrice)
+		}
+		feePolicy.MaxFeePrice = maxGasPrice
+	}
+	if bumpPercent, isSet := maybeGasBumpPercent.Uint64(); isSet {
+		if bumpPercent > math.MaxUint16 {
+			return nil, errors.Wrapf(ErrBadInput, "gasBumpPercent must be at most %d, got %d", math.MaxUint16, bumpPercent)
+		}
+		percent := uint16(bumpPercent)
+		feePolicy.BumpPercent = &percent
+	}
+	if feePolicy.MaxFeePrice == nil && feePolicy.BumpPercent == nil {
+		return nil, nil
+	}
+	return &feePolicy, nil
+}
+
 func decodeMeta(metaMap MapParam) (*txmgr.TxMeta, error) {
 	var txMeta txmgr.TxMeta
 	metaDecoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
@@ -1,6 +1,7 @@
 package pipeline_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -12,6 +13,7 @@ import (
 
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
@@ -605,4 +607,70 @@ func TestETHTxTask(t *testing.T) {
 	}
 }
 
+func TestETHTxTask_PriorityAndFeePolicy(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	newTask := func(t *testing.T, priority, maxGasPrice, gasBumpPercent string) (pipeline.ETHTxTask, *txmmocks.MockEvmTxManager) {
+		task := pipeline.ETHTxTask{
+			BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:             from.String(),
+			To:               to.String(),
+			Data:             "foobar",
+			GasLimit:         "12345",
+			MinConfirmations: "0",
+			EVMChainID:       "0",
+			Priority:         priority,
+			MaxGasPrice:      maxGasPrice,
+			GasBumpPercent:   gasBumpPercent,
+		}
+
+		keyStore := keystoremocks.NewEth(t)
+		txManager := txmmocks.NewMockEvmTxManager(t)
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewGeneralConfig(t, nil)
+		relayExtenders := evmtest.NewChainRelayExtenders(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+			TxManager: txManager, KeyStore: keyStore})
+		legacyChains := evmrelay.NewLegacyChainsFromRelayerExtenders(relayExtenders)
+		keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil).Maybe()
+		task.HelperSetDependencies(legacyChains, keyStore, nil, pipeline.DirectRequestJobType)
+		return task, txManager
+	}
+
+	t.Run("sets priority and fee policy", func(t *testing.T) {
+		task, txManager := newTask(t, "100", "2000000000", "50")
+		txManager.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(txRequest txmgr.TxRequest) bool {
+			return txRequest.Priority == txmgrtypes.TxPriorityHigh &&
+				txRequest.FeePolicy != nil &&
+				txRequest.FeePolicy.MaxFeePrice.Cmp(big.NewInt(2000000000)) == 0 &&
+				*txRequest.FeePolicy.BumpPercent == 50
+		})).Return(txmgr.Tx{}, nil).Once()
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("leaves fee policy unset by default", func(t *testing.T) {
+		task, txManager := newTask(t, "", "", "")
+		txManager.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(txRequest txmgr.TxRequest) bool {
+			return txRequest.Priority == txmgrtypes.TxPriorityDefault && txRequest.FeePolicy == nil
+		})).Return(txmgr.Tx{}, nil).Once()
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("errors on invalid fee policy", func(t *testing.T) {
+		task, _ := newTask(t, "", "0", "")
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+		require.ErrorContains(t, result.Error, "maxGasPrice")
+
+		task, _ = newTask(t, "", "", "70000")
+		result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+		require.ErrorContains(t, result.Error, "gasBumpPercent")
+	})
+}
+
 func ptr[T any](t T) *T { return &t }
@@ -0,0 +1,504 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/vm/runtime"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ExprTask evaluates an expression over the pipeline's variables, e.g.
+//
+//	expr [type="expr" expression="round($(ds1_parse.price) * 10 ** 18) > $(threshold) ? 'up' : 'down'"]
+//
+// Variables are referenced the same way as in the parameters of other tasks, outside of string
+// literals. The expression language (https://expr-lang.org/docs/language-definition) is sandboxed:
+// expressions cannot call Go methods or functions other than the language's builtins, and both the
+// size of an expression and of the numbers it computes are bounded.
+//
+// Unlike the language's default float semantics, numbers are exact decimals: arithmetic,
+// comparisons and the numeric builtins abs, ceil, floor, round, int, float, max, min, sum,
+// mean and median all operate on decimals, so results match those of the math tasks.
+//
+// Return types:
+//
+//	decimal.Decimal
+//	bool
+//	string
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type ExprTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Expression string `json:"expression"`
+}
+
+var _ Task = (*ExprTask)(nil)
+
+const (
+	// maxExprExponent bounds the exponent of `**` so an expression can't allocate arbitrarily large numbers.
+	maxExprExponent = 1000
+	// maxExprDecimalBits bounds the size of the numbers computed by an expression, which would otherwise
+	// grow without limit through repeated multiplication and exponentiation.
+	maxExprDecimalBits = 8192
+	// maxExprNodes bounds the size of an expression's syntax tree, and so the time taken to evaluate it.
+	// The builtins allocating lists, e.g. map and filter, are bounded by the VM's own memory budget.
+	maxExprNodes = 1000
+)
+
+func (t *ExprTask) Type() TaskType {
+	return TaskTypeExpr
+}
+
+func (t *ExprTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var expression StringParam
+	if err = ResolveParam(&expression, From(NonemptyString(t.Expression))); err != nil {
+		return Result{Error: errors.Wrap(err, "expression")}, runInfo
+	}
+
+	value, err := evaluateExpression(string(expression), vars)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	return Result{Value: value}, runInfo
+}
+
+// evaluateExpression evaluates the expression over the variables, see ExprTask.
+func evaluateExpression(expression string, vars Vars) (interface{}, error) {
+	env := map[string]interface{}{}
+	var varErr error
+	rewritten := replaceExprVariables(expression, func(token string) string {
+		keypath := variableRegexp.FindStringSubmatch(token)[1]
+		val, err := vars.Get(keypath)
+		if err != nil && varErr == nil {
+			varErr = errors.Wrapf(err, "variable %s", keypath)
+		}
+		name := fmt.Sprintf("_var%d", len(env))
+		env[name] = toExprValue(val)
+		return name
+	})
+	if varErr != nil {
+		return nil, varErr
+	}
+
+	counter := &exprNodeCounter{}
+	opts := append([]expr.Option{expr.Env(env), expr.Patch(counter), expr.Patch(decimalPatcher{})}, exprDecimalFunctions...)
+	program, err := expr.Compile(rewritten, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "invalid expression: %v", err)
+	}
+	if counter.nodes > maxExprNodes {
+		return nil, errors.Wrapf(ErrBadInput, "expression has %d nodes, exceeding the maximum of %d", counter.nodes, maxExprNodes)
+	}
+
+	value, err := expr.Run(program, env)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to evaluate expression")
+	}
+
+	return fromExprValue(value), nil
+}
+
+// replaceExprVariables replaces the variables referenced in the expression with the result of `replace`.
+// String literals and comments are copied as they are, so they can contain text looking like a variable.
+func replaceExprVariables(expression string, replace func(token string) string) string {
+	var b strings.Builder
+	code := 0 // start of the code not yet copied
+	copyCode := func(end int) {
+		b.WriteString(variableRegexp.ReplaceAllStringFunc(expression[code:end], replace))
+	}
+	for i := 0; i < len(expression); i++ {
+		var end int
+		switch {
+		case expression[i] == '"' || expression[i] == '\'' || expression[i] == '`':
+			end = i + 1
+			for end < len(expression) && expression[end] != expression[i] {
+				if expression[end] == '\\' && expression[i] != '`' {
+					end++
+				}
+				end++
+			}
+			end++
+		case strings.HasPrefix(expression[i:], "//"):
+			end = i + strings.IndexByte(expression[i:]+"\n", '\n')
+		case strings.HasPrefix(expression[i:], "/*"):
+			end = strings.Index(expression[i+2:], "*/")
+			if end < 0 {
+				end = len(expression)
+			} else {
+				end += i + 4
+			}
+		default:
+			continue
+		}
+		end = min(end, len(expression))
+		copyCode(i)
+		b.WriteString(expression[i:end])
+		code = end
+		i = end - 1
+	}
+	copyCode(len(expression))
+	return b.String()
+}
+
+// exprNodeCounter counts the nodes of an expression's syntax tree.
+type exprNodeCounter struct {
+	nodes int
+}
+
+func (c *exprNodeCounter) Visit(*ast.Node) {
+	c.nodes++
+}
+
+// decimalPatcher replaces arithmetic and comparison operators with calls to the
+// decimal functions in exprDecimalFunctions.
+type decimalPatcher struct{}
+
+var exprOperatorFunctions = map[string]string{
+	"+":  "_add",
+	"-":  "_sub",
+	"*":  "_mul",
+	"/":  "_div",
+	"%":  "_mod",
+	"**": "_pow",
+	"^":  "_pow",
+	"==": "_eq",
+	"!=": "_neq",
+	"<":  "_lt",
+	">":  "_gt",
+	"<=": "_lte",
+	">=": "_gte",
+}
+
+func (decimalPatcher) Visit(node *ast.Node) {
+	switch n := (*node).(type) {
+	case *ast.BinaryNode:
+		if fn, ok := exprOperatorFunctions[n.Operator]; ok {
+			ast.Patch(node, &ast.CallNode{
+				Callee:    &ast.IdentifierNode{Value: fn},
+				Arguments: []ast.Node{n.Left, n.Right},
+			})
+		}
+	case *ast.UnaryNode:
+		if n.Operator == "-" {
+			ast.Patch(node, &ast.CallNode{
+				Callee:    &ast.IdentifierNode{Value: "_neg"},
+				Arguments: []ast.Node{n.Node},
+			})
+		}
+	}
+}
+
+var exprDecimalFunctions = []expr.Option{
+	expr.Function("_add", exprArithmetic(decimal.Decimal.Add, runtime.Add)),
+	expr.Function("_sub", exprArithmetic(decimal.Decimal.Sub, runtime.Subtract)),
+	expr.Function("_mul", exprArithmetic(decimal.Decimal.Mul, runtime.Multiply)),
+	expr.Function("_div", exprDivide),
+	expr.Function("_mod", exprModulo),
+	expr.Function("_pow", exprPow),
+	expr.Function("_neg", exprNegate),
+	expr.Function("_eq", exprEqual),
+	expr.Function("_neq", func(params ...any) (any, error) {
+		eq, err := exprEqual(params...)
+		return !eq.(bool), err
+	}),
+	expr.Function("_lt", exprComparison(func(c int) bool { return c < 0 }, runtime.Less)),
+	expr.Function("_gt", exprComparison(func(c int) bool { return c > 0 }, runtime.More)),
+	expr.Function("_lte", exprComparison(func(c int) bool { return c <= 0 }, runtime.LessOrEqual)),
+	expr.Function("_gte", exprComparison(func(c int) bool { return c >= 0 }, runtime.MoreOrEqual)),
+
+	expr.Function("abs", exprUnary(decimal.Decimal.Abs)),
+	expr.Function("ceil", exprUnary(decimal.Decimal.Ceil)),
+	expr.Function("floor", exprUnary(decimal.Decimal.Floor)),
+	expr.Function("int", exprUnary(func(d decimal.Decimal) decimal.Decimal { return d.Truncate(0) })),
+	expr.Function("float", exprUnary(func(d decimal.Decimal) decimal.Decimal { return d })),
+	expr.Function("decimal", exprUnary(func(d decimal.Decimal) decimal.Decimal { return d })),
+	expr.Function("round", exprRound),
+	expr.Function("max", exprAggregate(func(ds []decimal.Decimal) decimal.Decimal { return decimal.Max(ds[0], ds[1:]...) })),
+	expr.Function("min", exprAggregate(func(ds []decimal.Decimal) decimal.Decimal { return decimal.Min(ds[0], ds[1:]...) })),
+	expr.Function("sum", exprAggregate(func(ds []decimal.Decimal) decimal.Decimal { return decimal.Sum(ds[0], ds[1:]...) })),
+	expr.Function("mean", exprAggregate(func(ds []decimal.Decimal) decimal.Decimal { return decimal.Avg(ds[0], ds[1:]...) })),
+	expr.Function("median", exprAggregate(exprMedian)),
+}
+
+// exprNumber returns the value as a decimal if it's a number.
+func exprNumber(v any) (decimal.Decimal, bool) {
+	switch v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, decimal.Decimal:
+		d, err := utils.ToDecimal(v)
+		return d, err == nil
+	}
+	return decimal.Decimal{}, false
+}
+
+// exprInts returns true if both values are ints. Arithmetic on ints returns ints where possible,
+// so they can still be used as indexes and counts.
+func exprInts(a, b any) bool {
+	_, aok := a.(int)
+	_, bok := b.(int)
+	return aok && bok
+}
+
+// exprResult returns the result as an int if `ints` is set and it's an integer which fits into one.
+func exprResult(d decimal.Decimal, ints bool) any {
+	if ints && d.IsInteger() && d.BigInt().IsInt64() {
+		if i := d.IntPart(); i >= math.MinInt && i <= math.MaxInt {
+			return int(i)
+		}
+	}
+	return d
+}
+
+func exprOperands(params []any) (a, b decimal.Decimal, ok bool) {
+	a, aok := exprNumber(params[0])
+	b, bok := exprNumber(params[1])
+	return a, b, aok && bok
+}
+
+// exprCheckSize returns an error if the number exceeds maxExprDecimalBits.
+func exprCheckSize(d decimal.Decimal) error {
+	if bits := d.Coefficient().BitLen(); bits > maxExprDecimalBits {
+		return errors.Errorf("result of %d bits exceeds the maximum of %d", bits, maxExprDecimalBits)
+	}
+	return nil
+}
+
+func exprArithmetic(op func(decimal.Decimal, decimal.Decimal) decimal.Decimal, fallback func(a, b interface{}) interface{}) func(params ...any) (any, error) {
+	return func(params ...any) (any, error) {
+		a, b, ok := exprOperands(params)
+		if !ok {
+			return fallback(params[0], params[1]), nil
+		}
+		d := op(a, b)
+		if err := exprCheckSize(d); err != nil {
+			return nil, err
+		}
+		return exprResult(d, exprInts(params[0], params[1])), nil
+	}
+}
+
+func exprDivide(params ...any) (any, error) {
+	a, b, ok := exprOperands(params)
+	if !ok {
+		return runtime.Divide(params[0], params[1]), nil
+	}
+	if b.IsZero() {
+		return nil, errors.New("division by zero")
+	}
+	return a.Div(b), nil
+}
+
+func exprModulo(params ...any) (any, error) {
+	a, b, ok := exprOperands(params)
+	if !ok {
+		return runtime.Modulo(params[0], params[1]), nil
+	}
+	if b.IsZero() {
+		return nil, errors.New("division by zero")
+	}
+	return exprResult(a.Mod(b), exprInts(params[0], params[1])), nil
+}
+
+func exprPow(params ...any) (any, error) {
+	a, b, ok := exprOperands(params)
+	if !ok {
+		return nil, errors.Errorf("invalid operation: %T ** %T", params[0], params[1])
+	}
+	if !b.IsInteger() {
+		return nil, errors.Errorf("exponent %s must be an integer", b)
+	}
+	if b.Abs().GreaterThan(decimal.NewFromInt(maxExprExponent)) {
+		return nil, errors.Errorf("exponent %s exceeds the maximum of %d", b, maxExprExponent)
+	}
+	if a.IsZero() && b.IsNegative() {
+		return nil, errors.New("division by zero")
+	}
+	// check the size up front, as computing the power is what's expensive
+	if bits := int64(a.Coefficient().BitLen()-1) * b.Abs().IntPart(); bits > maxExprDecimalBits {
+		return nil, errors.Errorf("result of about %d bits exceeds the maximum of %d", bits, maxExprDecimalBits)
+	}
+	d := a.Pow(b)
+	if err := exprCheckSize(d); err != nil {
+		return nil, err
+	}
+	return exprResult(d, exprInts(params[0], params[1]) && !b.IsNegative()), nil
+}
+
+func exprNegate(params ...any) (any, error) {
+	d, ok := exprNumber(params[0])
+	if !ok {
+		return runtime.Negate(params[0]), nil
+	}
+	_, isInt := params[0].(int)
+	return exprResult(d.Neg(), isInt), nil
+}
+
+func exprEqual(params ...any) (any, error) {
+	if a, b, ok := exprOperands(params); ok {
+		return a.Equal(b), nil
+	}
+	return runtime.Equal(params[0], params[1]), nil
+}
+
+func exprComparison(cmp func(int) bool, fallback func(a, b interface{}) bool) func(params ...any) (any, error) {
+	return func(params ...any) (any, error) {
+		a, b, ok := exprOperands(params)
+		if !ok {
+			return fallback(params[0], params[1]), nil
+		}
+		return cmp(a.Cmp(b)), nil
+	}
+}
+
+// exprUnary converts its single argument, a number or numeric string, to a decimal before applying `op`.
+func exprUnary(op func(decimal.Decimal) decimal.Decimal) func(params ...any) (any, error) {
+	return func(params ...any) (any, error) {
+		if len(params) != 1 {
+			return nil, errors.Errorf("expected 1 argument, got %d", len(params))
+		}
+		if s, ok := params[0].(string); ok {
+			d, err := decimal.NewFromString(s)
+			if err != nil {
+				return nil, err
+			}
+			return op(d), nil
+		}
+		d, ok := exprNumber(params[0])
+		if !ok {
+			return nil, errors.Errorf("expected a number, got %T", params[0])
+		}
+		return op(d), nil
+	}
+}
+
+// exprRound rounds to the nearest integer, or to the number of decimal places given as the optional second argument.
+func exprRound(params ...any) (any, error) {
+	if len(params) == 0 || len(params) > 2 {
+		return nil, errors.Errorf("expected 1 or 2 arguments, got %d", len(params))
+	}
+	d, ok := exprNumber(params[0])
+	if !ok {
+		return nil, errors.Errorf("expected a number, got %T", params[0])
+	}
+	places := int32(0)
+	if len(params) == 2 {
+		p, ok := params[1].(int)
+		if !ok || p < math.MinInt32 || p > math.MaxInt32 {
+			return nil, errors.Errorf("expected an integer number of places, got %v", params[1])
+		}
+		places = int32(p)
+	}
+	return d.Round(places), nil
+}
+
+// exprAggregate applies `op` to its arguments, or to the elements of its only argument if that's a list.
+func exprAggregate(op func([]decimal.Decimal) decimal.Decimal) func(params ...any) (any, error) {
+	return func(params ...any) (any, error) {
+		if len(params) == 1 {
+			if list, ok := params[0].([]interface{}); ok {
+				params = list
+			}
+		}
+		if len(params) == 0 {
+			return nil, errors.New("expected at least 1 number")
+		}
+		ds := make([]decimal.Decimal, len(params))
+		for i, p := range params {
+			d, ok := exprNumber(p)
+			if !ok {
+				return nil, errors.Errorf("expected a number, got %T", p)
+			}
+			ds[i] = d
+		}
+		return op(ds), nil
+	}
+}
+
+func exprMedian(ds []decimal.Decimal) decimal.Decimal {
+	sorted := make([]decimal.Decimal, len(ds))
+	copy(sorted, ds)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+	k := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[k]
+	}
+	return sorted[k-1].Add(sorted[k]).Div(decimal.NewFromInt(2))
+}
+
+// toExprValue converts numbers within the value to decimals.
+func toExprValue(v interface{}) interface{} {
+	switch tv := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(tv))
+		for k, el := range tv {
+			m[k] = toExprValue(el)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(tv))
+		for i, el := range tv {
+			l[i] = toExprValue(el)
+		}
+		return l
+	case *decimal.Decimal:
+		if tv == nil {
+			return nil
+		}
+		return *tv
+	case *big.Int:
+		if tv == nil {
+			return nil
+		}
+		return decimal.NewFromBigInt(tv, 0)
+	case big.Int:
+		return decimal.NewFromBigInt(&tv, 0)
+	case float32, float64:
+		if d, ok := exprNumber(tv); ok {
+			return d
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// fromExprValue converts the numbers within the value returned by an expression to decimals.
+func fromExprValue(v interface{}) interface{} {
+	switch tv := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(tv))
+		for k, el := range tv {
+			m[k] = fromExprValue(el)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(tv))
+		for i, el := range tv {
+			l[i] = fromExprValue(el)
+		}
+		return l
+	}
+	if d, ok := exprNumber(v); ok {
+		return d
+	}
+	return v
+}
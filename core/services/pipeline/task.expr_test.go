@@ -0,0 +1,104 @@
+package pipeline_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestExprTask(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"ds1": map[string]interface{}{
+			"price":  "1234.5678",
+			"prices": []interface{}{float64(3), decimal.RequireFromString("1.5"), big.NewInt(2)},
+			"symbol": "ETH",
+			"stale":  false,
+		},
+		"decimals":  int64(18),
+		"threshold": decimal.RequireFromString("0.1"),
+	})
+
+	tests := []struct {
+		name       string
+		expression string
+		want       interface{}
+		wantErr    string
+	}{
+		{"exact arithmetic", "float($(ds1.price)) * 10 ** $(decimals)", *mustDecimal(t, "1234567800000000000000"), ""},
+		{"decimal literals", "0.1 + 0.2", *mustDecimal(t, "0.3"), ""},
+		{"integer arithmetic", "7 % 4 - -1", *mustDecimal(t, "4"), ""},
+		{"division", "1 / 4", *mustDecimal(t, "0.25"), ""},
+		{"comparison", "0.1 + 0.2 == 0.3 && $(threshold) < 1 && $(threshold) != 0.2", true, ""},
+		{"boolean logic", "!$(ds1.stale) and ($(decimals) > 6 or false)", true, ""},
+		{"conditional", "$(threshold) >= 0.5 ? 'high' : 'low'", "low", ""},
+		{"string functions", "lower($(ds1.symbol)) + '/' + upper('usd')", "eth/USD", ""},
+		{"list functions", "median($(ds1.prices)) + max($(ds1.prices)) + sum(map($(ds1.prices), # * 2))", *mustDecimal(t, "18"), ""},
+		{"filter", "filter($(ds1.prices), # > 1.5)", []interface{}{*mustDecimal(t, "3"), *mustDecimal(t, "2")}, ""},
+		{"index", "$(ds1.prices)[len($(ds1.prices)) - 1]", *mustDecimal(t, "2"), ""},
+		{"rounding", "round(2.345, 2) + floor(1.9) + ceil(-1.1) + abs(-1) + int('3.7')", *mustDecimal(t, "6.35"), ""},
+		{"map", "{'price': decimal($(ds1.price)), 'symbol': $(ds1.symbol)}", map[string]interface{}{"price": *mustDecimal(t, "1234.5678"), "symbol": "ETH"}, ""},
+		{"nil", "nil", nil, ""},
+		{"variables in string literals", `$(ds1.symbol) + " $(ds1.price) " + '\'$(decimals)' + ` + "`$(threshold)`", "ETH $(ds1.price) '$(decimals)$(threshold)", ""},
+		{"variables in comments", "$(decimals) // $(ds2.price) isn't used\n/* nor $(ds2.volume) */ + 1", *mustDecimal(t, "19"), ""},
+
+		{"missing variable", "$(ds2.price) * 2", nil, "variable ds2.price"},
+		{"syntax error", "1 +", nil, "invalid expression"},
+		{"division by zero", "1 / 0", nil, "division by zero"},
+		{"fractional exponent", "2 ** 0.5", nil, "exponent 0.5 must be an integer"},
+		{"exponent too large", "10 ** 100000", nil, "exceeds the maximum"},
+		{"power too large", "(10 ** 1000) ** 1000", nil, "exceeds the maximum"},
+		{"product too large", "10 ** 1000 * 10 ** 1000 * 10 ** 1000", nil, "exceeds the maximum"},
+		{"expression too large", strings.Repeat("1 + ", 1000) + "1", nil, "exceeding the maximum"},
+		{"type mismatch", "$(ds1.symbol) * 2", nil, "failed to evaluate expression"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ExprTask{
+				BaseTask:   pipeline.NewBaseTask(0, "expr", nil, nil, 0),
+				Expression: test.expression,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars.Copy(), nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErr != "" {
+				require.Error(t, result.Error)
+				assert.Contains(t, result.Error.Error(), test.wantErr)
+				return
+			}
+
+			require.NoError(t, result.Error)
+			// decimals are compared by value, regardless of their exponent
+			want, err := json.Marshal(test.want)
+			require.NoError(t, err)
+			got, err := json.Marshal(result.Value)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(want), string(got))
+			assert.IsType(t, test.want, result.Value)
+		})
+	}
+}
+
+func TestExprTask_EmptyExpression(t *testing.T) {
+	t.Parallel()
+
+	task := pipeline.ExprTask{BaseTask: pipeline.NewBaseTask(0, "expr", nil, nil, 0)}
+	result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	assert.True(t, errors.Is(result.Error, pipeline.ErrParameterEmpty))
+}
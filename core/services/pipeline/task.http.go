@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -14,6 +15,12 @@ import (
 	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
 )
 
+// HTTPTask sends an HTTP request and returns the response body. If `cacheTTL` is set, successful responses
+// are cached in memory for that duration and shared with the HTTP tasks of all jobs on the node, and concurrent
+// identical requests are coalesced into one. Requests are identical if their method, URL, body and headers are.
+//
+//	ds [type="http" method=GET url="https://example.com/eth-usd" cacheTTL="5s"]
+//
 // Return types:
 //
 //	string
@@ -24,10 +31,13 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	CacheTTL                       string `json:"cacheTTL"`
 
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	cache                  *httpResponseCache
+	limiter                *httpRequestLimiter
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -45,6 +55,12 @@ var (
 	},
 		[]string{"pipeline_task_spec_id"},
 	)
+	promHTTPCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_requests",
+		Help: "The number of requests of HTTP tasks with a cacheTTL, by whether the response was cached (hit), shared with a concurrent request (coalesced) or fetched (miss)",
+	},
+		[]string{"pipeline_task_spec_id", "result"},
+	)
 )
 
 func (t *HTTPTask) Type() TaskType {
@@ -63,6 +79,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		requestData                    MapParam
 		allowUnrestrictedNetworkAccess BoolParam
 		reqHeaders                     StringSliceParam
+		cacheTTL                       Uint64Param
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
@@ -73,6 +90,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		// You must set allowUnrestrictedNetworkAccess=true on the task to enable variable-interpolated URLs to make restricted network requests
 		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), 0)), "cacheTTL"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -103,23 +121,48 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	} else {
 		client = t.httpClient
 	}
-	responseBytes, statusCode, respHeaders, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+	send := func(ctx context.Context) (httpResponse, error) {
+		release, err := t.limiter.acquire(ctx, url.Hostname(), "")
+		if err != nil {
+			return httpResponse{}, err
+		}
+		defer release()
+		body, statusCode, headers, elapsed, err := makeHTTPRequest(ctx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+		return httpResponse{body: body, statusCode: statusCode, headers: headers, elapsed: elapsed}, err
+	}
+
+	var response httpResponse
+	cacheResult := httpCacheMiss
+	if cacheTTL > 0 && t.cache != nil {
+		key, err2 := httpCacheKey(method, url, requestData, reqHeaders, allowUnrestrictedNetworkAccess)
+		if err2 != nil {
+			return Result{Error: err2}, runInfo
+		}
+		response, cacheResult, err = t.cache.fetch(requestCtx, key, time.Duration(cacheTTL)*time.Second, send)
+		promHTTPCacheRequests.WithLabelValues(t.DotID(), string(cacheResult)).Inc()
+	} else {
+		response, err = send(requestCtx)
+	}
 	if err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
 		}
-		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(response.statusCode, err)}
 	}
+	responseBytes := response.body
 
 	lggr.Debugw("HTTP task got response",
 		"response", string(responseBytes),
-		"respHeaders", respHeaders,
+		"respHeaders", response.headers,
 		"url", url.String(),
 		"dotID", t.DotID(),
+		"cache", cacheResult,
 	)
 
-	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
-	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
+	if cacheResult == httpCacheMiss {
+		promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(response.elapsed))
+		promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
+	}
 
 	// NOTE: We always stringify the response since this is required for all current jobs.
 	// If a binary response is required we might consider adding an adapter
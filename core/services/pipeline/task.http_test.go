@@ -1,11 +1,16 @@
 package pipeline_test
 
 import (
+	"context"
 	"encoding/json"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -17,6 +22,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
@@ -412,3 +418,224 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestHTTPTask_Cache(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	var requests atomic.Int32
+	var fail atomic.Bool
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path == "/slow" {
+			<-release
+		}
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(`{"result": 42}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	newTask := func(path, cacheTTL string, cache *pipeline.HTTPResponseCache) *pipeline.HTTPTask {
+		task := &pipeline.HTTPTask{
+			BaseTask: pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:   "GET",
+			URL:      server.URL + path,
+			CacheTTL: cacheTTL,
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(config.JobPipeline(), c, c)
+		task.HelperSetCache(cache)
+		return task
+	}
+	run := func(task *pipeline.HTTPTask) pipeline.Result {
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	t.Run("caches responses within the TTL", func(t *testing.T) {
+		requests.Store(0)
+		cache := pipeline.NewHTTPResponseCache()
+		for i := 0; i < 3; i++ {
+			result := run(newTask("/cached", "1m", cache))
+			require.NoError(t, result.Error)
+			assert.Equal(t, `{"result": 42}`, result.Value)
+		}
+		assert.Equal(t, int32(1), requests.Load())
+
+		// a different request isn't served from the cache
+		require.NoError(t, run(newTask("/cached?other", "1m", cache)).Error)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("serves responses within the TTL of each task", func(t *testing.T) {
+		requests.Store(0)
+		cache := pipeline.NewHTTPResponseCache()
+		require.NoError(t, run(newTask("/shared", "1m", cache)).Error)
+		time.Sleep(1100 * time.Millisecond)
+
+		// the response is too old for a task with a shorter TTL...
+		require.NoError(t, run(newTask("/shared", "1s", cache)).Error)
+		assert.Equal(t, int32(2), requests.Load())
+		// ...which refreshes it for both
+		require.NoError(t, run(newTask("/shared", "1s", cache)).Error)
+		require.NoError(t, run(newTask("/shared", "1m", cache)).Error)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("doesn't cache without a cacheTTL", func(t *testing.T) {
+		requests.Store(0)
+		cache := pipeline.NewHTTPResponseCache()
+		for i := 0; i < 3; i++ {
+			require.NoError(t, run(newTask("/uncached", "", cache)).Error)
+		}
+		assert.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("doesn't cache errors", func(t *testing.T) {
+		requests.Store(0)
+		cache := pipeline.NewHTTPResponseCache()
+		fail.Store(true)
+		require.Error(t, run(newTask("/failing", "1m", cache)).Error)
+		fail.Store(false)
+		require.NoError(t, run(newTask("/failing", "1m", cache)).Error)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("coalesces concurrent requests", func(t *testing.T) {
+		requests.Store(0)
+		cache := pipeline.NewHTTPResponseCache()
+		var wg sync.WaitGroup
+		results := make([]pipeline.Result, 5)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i] = run(newTask("/slow", "1m", cache))
+			}(i)
+		}
+		require.Eventually(t, func() bool { return requests.Load() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		// give the remaining tasks time to join the request in flight
+		time.Sleep(100 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		for _, result := range results {
+			require.NoError(t, result.Error)
+			assert.Equal(t, `{"result": 42}`, result.Value)
+		}
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("evicts the least recently used response when full", func(t *testing.T) {
+		requests.Store(0)
+		cache := pipeline.NewHTTPResponseCache()
+		cache.HelperSetMaxEntries(2)
+		require.NoError(t, run(newTask("/lru?a", "1m", cache)).Error)
+		require.NoError(t, run(newTask("/lru?b", "1m", cache)).Error)
+		require.NoError(t, run(newTask("/lru?a", "1m", cache)).Error)
+		assert.Equal(t, int32(2), requests.Load())
+
+		// b is evicted for c, since a was used more recently
+		require.NoError(t, run(newTask("/lru?c", "1m", cache)).Error)
+		require.NoError(t, run(newTask("/lru?a", "1m", cache)).Error)
+		assert.Equal(t, int32(3), requests.Load())
+		require.NoError(t, run(newTask("/lru?b", "1m", cache)).Error)
+		assert.Equal(t, int32(4), requests.Load())
+	})
+}
+
+func TestHTTPTask_Limits(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	var inFlight, maxInFlight, requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			if m := maxInFlight.Load(); n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		_, err := w.Write([]byte(`{}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	host := server.Listener.Addr().(*net.TCPAddr).IP.String()
+
+	runTasks := func(ctx context.Context, limiter *pipeline.HTTPRequestLimiter, n int) []pipeline.Result {
+		results := make([]pipeline.Result, n)
+		var wg sync.WaitGroup
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				task := pipeline.HTTPTask{
+					BaseTask: pipeline.NewBaseTask(0, "http", nil, nil, 0),
+					Method:   "GET",
+					URL:      server.URL,
+				}
+				c := clhttptest.NewTestLocalOnlyHTTPClient()
+				task.HelperSetDependencies(config.JobPipeline(), c, c)
+				task.HelperSetLimiter(limiter)
+				results[i], _ = task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			}(i)
+		}
+		wg.Wait()
+		return results
+	}
+
+	t.Run("concurrency", func(t *testing.T) {
+		maxInFlight.Store(0)
+		limiter := pipeline.NewHTTPRequestLimiter(map[string]coreconfig.JobPipelineRequestLimit{host: {MaxConcurrent: 2}}, nil)
+		for _, result := range runTasks(testutils.Context(t), limiter, 6) {
+			require.NoError(t, result.Error)
+		}
+		assert.Equal(t, int32(2), maxInFlight.Load())
+	})
+
+	t.Run("rate", func(t *testing.T) {
+		limiter := pipeline.NewHTTPRequestLimiter(map[string]coreconfig.JobPipelineRequestLimit{host: {MaxRequestsPerSecond: 20}}, nil)
+		start := time.Now()
+		for _, result := range runTasks(testutils.Context(t), limiter, 5) {
+			require.NoError(t, result.Error)
+		}
+		// the first request is sent immediately, then one every 50ms
+		assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	})
+
+	t.Run("other hosts are unlimited", func(t *testing.T) {
+		maxInFlight.Store(0)
+		limiter := pipeline.NewHTTPRequestLimiter(map[string]coreconfig.JobPipelineRequestLimit{"api.example.com": {MaxConcurrent: 1}}, nil)
+		for _, result := range runTasks(testutils.Context(t), limiter, 3) {
+			require.NoError(t, result.Error)
+		}
+		assert.Equal(t, int32(3), maxInFlight.Load())
+	})
+
+	t.Run("queued requests time out", func(t *testing.T) {
+		requests.Store(0)
+		limiter := pipeline.NewHTTPRequestLimiter(map[string]coreconfig.JobPipelineRequestLimit{host: {MaxConcurrent: 1}}, nil)
+		ctx, cancel := context.WithTimeout(testutils.Context(t), 75*time.Millisecond)
+		defer cancel()
+		// the first request completes, the second is sent once it has but times out, and the third times out in the queue
+		var completed, queued int
+		for _, result := range runTasks(ctx, limiter, 3) {
+			if result.Error == nil {
+				completed++
+			} else if strings.Contains(result.Error.Error(), "waiting for the concurrency limit of host") {
+				queued++
+			}
+		}
+		assert.Equal(t, 1, completed)
+		assert.Equal(t, 1, queued)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+}
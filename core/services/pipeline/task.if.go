@@ -0,0 +1,85 @@
+package pipeline
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// IfTask routes execution depending on a condition, which is an expression as evaluated by ExprTask.
+// `then` and `else` list the DOT IDs of the outputs which run if the condition is true or false respectively,
+// separated by commas. The outputs on the other branch are skipped, and outputs listed in neither always run.
+// If `condition` is empty, the task's input is used instead.
+//
+//	route [type="if" condition="$(decode_cbor.amount) > 100" then="large_payment" else="small_payment"]
+//
+// Return types:
+//
+//	bool
+type IfTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Condition string `json:"condition"`
+	Then      string `json:"then"`
+	Else      string `json:"else"`
+}
+
+var (
+	_ Task          = (*IfTask)(nil)
+	_ branchingTask = (*IfTask)(nil)
+)
+
+func (t *IfTask) Type() TaskType {
+	return TaskTypeIf
+}
+
+func (t *IfTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	conditionGetter := Input(inputs, 0)
+	if strings.TrimSpace(t.Condition) != "" {
+		conditionGetter = func() (interface{}, error) {
+			return evaluateExpression(t.Condition, vars)
+		}
+	}
+
+	var condition BoolParam
+	if err = ResolveParam(&condition, From(conditionGetter)); err != nil {
+		return Result{Error: errors.Wrap(err, "condition")}, runInfo
+	}
+
+	return Result{Value: bool(condition)}, runInfo
+}
+
+func (t *IfTask) branchTargets() ([]string, error) {
+	return append(splitDotIDs(t.Then), splitDotIDs(t.Else)...), nil
+}
+
+func (t *IfTask) skippedOutputs(result Result) map[string]bool {
+	notTaken := t.Else
+	if condition, _ := result.Value.(bool); !condition {
+		notTaken = t.Then
+	}
+
+	skipped := map[string]bool{}
+	for _, id := range splitDotIDs(notTaken) {
+		skipped[id] = true
+	}
+	return skipped
+}
+
+// splitDotIDs splits a comma separated list of DOT IDs.
+func splitDotIDs(s string) []string {
+	ids := []string{}
+	for _, id := range strings.Split(s, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
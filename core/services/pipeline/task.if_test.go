@@ -0,0 +1,60 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestIfTask(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"decode": map[string]interface{}{"amount": "150", "urgent": true},
+	})
+
+	tests := []struct {
+		name      string
+		condition string
+		inputs    []pipeline.Result
+		want      bool
+		wantErr   bool
+	}{
+		{"expression", "decimal($(decode.amount)) > 100", nil, true, false},
+		{"variable", "$(decode.urgent)", nil, true, false},
+		{"literal", "false", nil, false, false},
+		{"input", "", []pipeline.Result{{Value: "true"}}, true, false},
+		{"input errored", "", []pipeline.Result{{Error: pipeline.ErrBadInput}}, false, true},
+		{"not a bool", "$(decode.amount)", nil, false, true},
+		{"missing variable", "$(decode.missing) > 1", nil, false, true},
+		{"no condition", "", nil, false, true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.IfTask{
+				BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Condition: test.condition,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErr {
+				require.Error(t, result.Error)
+				require.Nil(t, result.Value)
+				return
+			}
+			require.NoError(t, result.Error)
+			require.Equal(t, test.want, result.Value)
+		})
+	}
+}
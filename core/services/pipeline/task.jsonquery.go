@@ -0,0 +1,98 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// JSONQueryTask evaluates a JSONPath query, see JSONPath, against JSON data. Queries which can match
+// more than one value, e.g. `$.data[?(@.exchange != 'x')].price`, return the list of matched values,
+// while queries ending in a function return its result.
+//
+// Return types:
+//
+//	float64
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	decimal.Decimal
+//	nil
+type JSONQueryTask struct {
+	BaseTask `mapstructure:",squash"`
+	Query    string `json:"query"`
+	Data     string `json:"data"`
+	// Lax when disabled will return an error if a query for a single value doesn't match
+	// Lax when enabled will return nil with no error if a query for a single value doesn't match
+	Lax string `json:"lax"`
+}
+
+var _ Task = (*JSONQueryTask)(nil)
+
+func (t *JSONQueryTask) Type() TaskType {
+	return TaskTypeJSONQuery
+}
+
+func (t *JSONQueryTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		query StringParam
+		data  BytesParam
+		lax   BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&query, From(VarExpr(t.Query, vars), NonemptyString(t.Query))), "query"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	path, err := ParseJSONPath(string(query))
+	if err != nil {
+		return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
+	}
+
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	err = d.Decode(&decoded)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	matches, err := path.Evaluate(decoded)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var value interface{} = matches
+	if path.IsDefinite() || path.function != "" {
+		if len(matches) == 0 {
+			if !bool(lax) {
+				return Result{Error: errors.Wrapf(ErrKeypathNotFound, "could not resolve query %s in %s", query, data)}, runInfo
+			}
+			return Result{Value: nil}, runInfo
+		}
+		value = matches[0]
+	}
+
+	value, err = jsonserializable.ReinterpretJSONNumbers(value)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+
+	return Result{Value: value}, runInfo
+}
@@ -0,0 +1,160 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJSONQueryTask(t *testing.T) {
+	t.Parallel()
+
+	const response = `{"data":[{"exchange":"a","price":"100.5"},{"exchange":"x","price":"1"},{"exchange":"b","price":"101.5"}],"count":3}`
+
+	tests := []struct {
+		name           string
+		query          string
+		data           string
+		lax            string
+		vars           pipeline.Vars
+		inputs         []pipeline.Result
+		wantData       interface{}
+		wantErrorCause error
+	}{
+		{
+			"single value",
+			"$.count",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: response}},
+			int64(3),
+			nil,
+		},
+		{
+			"filter",
+			"$.data[?(@.exchange != 'x')].price",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: response}},
+			[]interface{}{"100.5", "101.5"},
+			nil,
+		},
+		{
+			"median",
+			"$.data[*].price.median()",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: response}},
+			*mustDecimal(t, "100.5"),
+			nil,
+		},
+		{
+			"data and query from vars",
+			"$(query)",
+			"$(ds.body)",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"query": "$.data[-1].exchange",
+				"ds":    map[string]interface{}{"body": response},
+			}),
+			nil,
+			"b",
+			nil,
+		},
+		{
+			"no matches",
+			"$.data[?(@.exchange == 'z')]",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: response}},
+			[]interface{}{},
+			nil,
+		},
+		{
+			"missing value",
+			"$.data[5].price",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: response}},
+			nil,
+			pipeline.ErrKeypathNotFound,
+		},
+		{
+			"missing value, lax",
+			"$.data[5].price",
+			"",
+			"true",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: response}},
+			nil,
+			nil,
+		},
+		{
+			"invalid query",
+			"$.data[",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: response}},
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"function of no values",
+			"$.data[?(@.exchange == 'z')].price.median()",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: response}},
+			nil,
+			pipeline.ErrWrongInputCardinality,
+		},
+		{
+			"empty query",
+			"",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: response}},
+			nil,
+			pipeline.ErrParameterEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.JSONQueryTask{
+				BaseTask: pipeline.NewBaseTask(0, "json", nil, nil, 0),
+				Query:    test.query,
+				Data:     test.data,
+				Lax:      test.lax,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				require.Nil(t, result.Value)
+				return
+			}
+
+			require.NoError(t, result.Error)
+			require.Equal(t, test.wantData, result.Value)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// MaxTask returns the largest of its values. Like MedianTask, it fails if more values are errors than allowedFaults.
+//
+// Return types:
+//
+//	*decimal.Decimal
+type MaxTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+}
+
+var _ Task = (*MaxTask)(nil)
+
+func (t *MaxTask) Type() TaskType {
+	return TaskTypeMax
+}
+
+func (t *MaxTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	values, err := resolveAggregationDecimals(t.Type(), t.Values, t.AllowedFaults, vars, inputs)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: values[len(values)-1]}, runInfo
+}
@@ -0,0 +1,54 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestMinTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		allowedFaults string
+		want          string
+		wantErr       error
+	}{
+		{"values", []pipeline.Result{{Value: mustDecimal(t, "2")}, {Value: "1"}, {Value: 4}, {Value: mustDecimal(t, "3")}}, "", "1", nil},
+		{"errors within allowed faults", []pipeline.Result{{Error: errors.New("")}, {Value: mustDecimal(t, "2")}, {Value: 4}}, "1", "2", nil},
+		{"more errors than allowed faults", []pipeline.Result{{Error: errors.New("")}, {Error: errors.New("")}, {Value: 4}}, "1", "", pipeline.ErrTooManyErrors},
+		{"zero inputs", []pipeline.Result{}, "0", "", pipeline.ErrWrongInputCardinality},
+		{"not a number", []pipeline.Result{{Value: "foo"}}, "", "", pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.MinTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+			require.Equal(t, test.want, output.Value.(decimal.Decimal).String())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// PercentileTask returns the `percentile`th percentile of its values, between 0 and 100 inclusive, interpolating
+// linearly between the closest values. The 50th percentile is the median. Like MedianTask, it fails if more values
+// are errors than allowedFaults.
+//
+//	p90 [type="percentile" percentile=90]
+//
+// Return types:
+//
+//	*decimal.Decimal
+type PercentileTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	Percentile    string `json:"percentile"`
+}
+
+var _ Task = (*PercentileTask)(nil)
+
+func (t *PercentileTask) Type() TaskType {
+	return TaskTypePercentile
+}
+
+func (t *PercentileTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var percentile DecimalParam
+	err := ResolveParam(&percentile, From(VarExpr(t.Percentile, vars), NonemptyString(t.Percentile)))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "percentile")}, runInfo
+	}
+	hundred := decimal.NewFromInt(100)
+	if percentile.Decimal().IsNegative() || percentile.Decimal().GreaterThan(hundred) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "percentile: must be between 0 and 100, got %s", percentile.Decimal())}, runInfo
+	}
+
+	values, err := resolveAggregationDecimals(t.Type(), t.Values, t.AllowedFaults, vars, inputs)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	rank := percentile.Decimal().Mul(decimal.NewFromInt(int64(len(values) - 1))).Div(hundred)
+	k := rank.IntPart()
+	fraction := rank.Sub(decimal.NewFromInt(k))
+	if fraction.IsZero() {
+		return Result{Value: values[k]}, runInfo
+	}
+	return Result{Value: values[k].Add(values[k+1].Sub(values[k]).Mul(fraction))}, runInfo
+}
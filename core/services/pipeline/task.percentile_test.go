@@ -0,0 +1,64 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestPercentileTask(t *testing.T) {
+	t.Parallel()
+
+	values := []pipeline.Result{{Value: 40}, {Value: 10}, {Value: 30}, {Value: 20}, {Value: 50}}
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		percentile    string
+		allowedFaults string
+		want          string
+		wantErr       error
+	}{
+		{"median", values, "50", "", "30", nil},
+		{"minimum", values, "0", "", "10", nil},
+		{"maximum", values, "100", "", "50", nil},
+		{"interpolated", values, "90", "", "46", nil},
+		{"fractional percentile", values, "12.5", "", "15", nil},
+		{"one value", []pipeline.Result{{Value: 7}}, "90", "", "7", nil},
+		{"errors within allowed faults", append([]pipeline.Result{{Error: errors.New("")}}, values...), "50", "1", "30", nil},
+		{"more errors than allowed faults", append([]pipeline.Result{{Error: errors.New("")}}, values...), "50", "0", "", pipeline.ErrTooManyErrors},
+		{"percentile too large", values, "101", "", "", pipeline.ErrBadInput},
+		{"no percentile given", values, "", "", "", pipeline.ErrParameterEmpty},
+		{"zero inputs", []pipeline.Result{}, "50", "0", "", pipeline.ErrWrongInputCardinality},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.PercentileTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Percentile:    test.percentile,
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+			require.Equal(t, test.want, output.Value.(decimal.Decimal).String())
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// StddevTask returns the standard deviation of its values. It is the population standard deviation unless `sample`
+// is true, in which case it is the sample standard deviation, which requires at least two values. The result is
+// rounded to `precision` decimal places, 16 by default. Like MedianTask, it fails if more values are errors than
+// allowedFaults.
+//
+//	spread [type="stddev" sample=true precision=4]
+//
+// Return types:
+//
+//	*decimal.Decimal
+type StddevTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	Sample        string `json:"sample"`
+	Precision     string `json:"precision"`
+}
+
+var _ Task = (*StddevTask)(nil)
+
+func (t *StddevTask) Type() TaskType {
+	return TaskTypeStddev
+}
+
+func (t *StddevTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		sample         BoolParam
+		maybePrecision MaybeInt32Param
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&sample, From(VarExpr(t.Sample, vars), NonemptyString(t.Sample), false)), "sample"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	values, err := resolveAggregationDecimals(t.Type(), t.Values, t.AllowedFaults, vars, inputs)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	precision := int32(decimal.DivisionPrecision)
+	if p, isSet := maybePrecision.Int32(); isSet {
+		if p < 0 {
+			return Result{Error: errors.Wrapf(ErrBadInput, "precision: must not be negative, got %d", p)}, runInfo
+		}
+		precision = p
+	}
+
+	n := int64(len(values))
+	if sample {
+		if n < 2 {
+			return Result{Error: errors.Wrap(ErrWrongInputCardinality, "sample standard deviation needs at least 2 values")}, runInfo
+		}
+		n--
+	}
+
+	total := decimal.Zero
+	for _, val := range values {
+		total = total.Add(val)
+	}
+	// keep extra digits so that rounding the mean and variance doesn't affect the result
+	mean := total.DivRound(decimal.NewFromInt(int64(len(values))), precision+16)
+
+	squares := decimal.Zero
+	for _, val := range values {
+		deviation := val.Sub(mean)
+		squares = squares.Add(deviation.Mul(deviation))
+	}
+	variance := squares.DivRound(decimal.NewFromInt(n), 2*precision+16)
+
+	return Result{Value: sqrtDecimal(variance, precision)}, runInfo
+}
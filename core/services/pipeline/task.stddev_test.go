@@ -0,0 +1,65 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestStddevTask(t *testing.T) {
+	t.Parallel()
+
+	values := []pipeline.Result{{Value: 2}, {Value: 4}, {Value: 4}, {Value: 4}, {Value: 5}, {Value: 5}, {Value: 7}, {Value: 9}}
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		sample        string
+		precision     string
+		allowedFaults string
+		want          string
+		wantErr       error
+	}{
+		{"population", values, "", "", "", "2", nil},
+		{"sample", values, "true", "4", "", "2.1381", nil},
+		{"fractional", []pipeline.Result{{Value: 1}, {Value: 2}}, "", "", "", "0.5", nil},
+		{"precision", []pipeline.Result{{Value: 0}, {Value: 1}, {Value: 2}}, "", "6", "", "0.816497", nil},
+		{"one value", []pipeline.Result{{Value: "1.5"}}, "", "", "", "0", nil},
+		{"sample of one value", []pipeline.Result{{Value: "1.5"}}, "true", "", "", "", pipeline.ErrWrongInputCardinality},
+		{"errors within allowed faults", append([]pipeline.Result{{Error: errors.New("")}}, values...), "", "", "1", "2", nil},
+		{"more errors than allowed faults", append([]pipeline.Result{{Error: errors.New("")}}, values...), "", "", "0", "", pipeline.ErrTooManyErrors},
+		{"negative precision", values, "", "-1", "", "", pipeline.ErrBadInput},
+		{"zero inputs", []pipeline.Result{}, "", "", "0", "", pipeline.ErrWrongInputCardinality},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.StddevTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Sample:        test.sample,
+				Precision:     test.precision,
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+			require.Equal(t, test.want, output.Value.(decimal.Decimal).String())
+		})
+	}
+}
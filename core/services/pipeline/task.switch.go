@@ -0,0 +1,137 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// SwitchTask routes execution depending on a value. `cases` is a JSON object mapping each case to the DOT ID,
+// or list of DOT IDs, of the outputs which run if the value matches it. Strings, bools and numbers match the case
+// with the same string representation. `default` lists the outputs which run if no case matches, separated by
+// commas. The outputs on all other branches are skipped, and outputs listed in no branch always run.
+//
+//	route [type="switch" value="$(jobRun.requestBody.action)" cases=<{"buy": "buy_order", "sell": ["sell_order", "notify"]}> default="reject"]
+//
+// Return types:
+//
+//	string: the matched case, or an empty string if none matched
+type SwitchTask struct {
+	BaseTask `mapstructure:",squash"`
+	Value    string `json:"value"`
+	Cases    string `json:"cases"`
+	Default  string `json:"default"`
+}
+
+var (
+	_ Task          = (*SwitchTask)(nil)
+	_ branchingTask = (*SwitchTask)(nil)
+)
+
+func (t *SwitchTask) Type() TaskType {
+	return TaskTypeSwitch
+}
+
+func (t *SwitchTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var value ObjectParam
+	if err = ResolveParam(&value, From(VarExpr(t.Value, vars), NonemptyString(t.Value), Input(inputs, 0))); err != nil {
+		return Result{Error: errors.Wrap(err, "value")}, runInfo
+	}
+
+	cases, err := t.cases()
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var key string
+	switch value.Type {
+	case StringType:
+		key = string(value.StringValue)
+	case BoolType:
+		key = strconv.FormatBool(bool(value.BoolValue))
+	case DecimalType:
+		key = value.DecimalValue.Decimal().String()
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "value: expected a string, bool or number, got %s", value)}, runInfo
+	}
+
+	if _, ok := cases[key]; !ok {
+		key = ""
+	}
+	return Result{Value: key}, runInfo
+}
+
+// cases parses the cases, mapping each case to the DOT IDs of its outputs.
+func (t *SwitchTask) cases() (map[string][]string, error) {
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(t.Cases), &raw); err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "cases: %v", err)
+	}
+
+	cases := make(map[string][]string, len(raw))
+	for key, outputs := range raw {
+		switch v := outputs.(type) {
+		case string:
+			cases[key] = []string{v}
+		case []interface{}:
+			for _, output := range v {
+				id, ok := output.(string)
+				if !ok {
+					return nil, errors.Wrapf(ErrBadInput, "cases: expected DOT IDs for case %q, got %v", key, output)
+				}
+				cases[key] = append(cases[key], id)
+			}
+		default:
+			return nil, errors.Wrapf(ErrBadInput, "cases: expected DOT IDs for case %q, got %v", key, outputs)
+		}
+	}
+	return cases, nil
+}
+
+func (t *SwitchTask) branchTargets() ([]string, error) {
+	cases, err := t.cases()
+	if err != nil {
+		return nil, err
+	}
+
+	targets := splitDotIDs(t.Default)
+	for _, ids := range cases {
+		targets = append(targets, ids...)
+	}
+	return targets, nil
+}
+
+func (t *SwitchTask) skippedOutputs(result Result) map[string]bool {
+	cases, err := t.cases()
+	if err != nil {
+		return nil
+	}
+
+	taken := splitDotIDs(t.Default)
+	if key, _ := result.Value.(string); key != "" {
+		taken = cases[key]
+	}
+
+	skipped := map[string]bool{}
+	for _, id := range splitDotIDs(t.Default) {
+		skipped[id] = true
+	}
+	for _, ids := range cases {
+		for _, id := range ids {
+			skipped[id] = true
+		}
+	}
+	for _, id := range taken {
+		delete(skipped, id)
+	}
+	return skipped
+}
@@ -0,0 +1,61 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestSwitchTask(t *testing.T) {
+	t.Parallel()
+
+	const cases = `{"buy": "buy_order", "sell": ["sell_order", "notify"], "true": "yes", "1.5": "ratio"}`
+
+	tests := []struct {
+		name    string
+		value   string
+		inputs  []pipeline.Result
+		vars    map[string]interface{}
+		want    string
+		wantErr bool
+	}{
+		{"literal", "sell", nil, nil, "sell", false},
+		{"variable", "$(request.action)", nil, map[string]interface{}{"request": map[string]interface{}{"action": "buy"}}, "buy", false},
+		{"input", "", []pipeline.Result{{Value: "buy"}}, nil, "buy", false},
+		{"bool", "$(flag)", nil, map[string]interface{}{"flag": true}, "true", false},
+		{"number", "$(ratio)", nil, map[string]interface{}{"ratio": decimal.RequireFromString("1.50")}, "1.5", false},
+		{"no match", "hold", nil, nil, "", false},
+		{"map", "$(request)", nil, map[string]interface{}{"request": map[string]interface{}{}}, "", true},
+		{"no value", "", nil, nil, "", true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.SwitchTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Value:    test.value,
+				Cases:    cases,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(test.vars), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErr {
+				require.Error(t, result.Error)
+				require.Nil(t, result.Value)
+				return
+			}
+			require.NoError(t, result.Error)
+			require.Equal(t, test.want, result.Value)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// TrimmedMeanTask returns the mean of its values after discarding the `trim` fraction of the smallest and of the
+// largest values, rounded down to a whole number of values. `trim` must be at least 0 and less than 0.5.
+// Like MedianTask, it fails if more values are errors than allowedFaults.
+//
+//	trimmed_mean [type="trimmedmean" trim="0.1" precision=2]
+//
+// Return types:
+//
+//	*decimal.Decimal
+type TrimmedMeanTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	Trim          string `json:"trim"`
+	Precision     string `json:"precision"`
+}
+
+var _ Task = (*TrimmedMeanTask)(nil)
+
+func (t *TrimmedMeanTask) Type() TaskType {
+	return TaskTypeTrimmedMean
+}
+
+func (t *TrimmedMeanTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		trim           DecimalParam
+		maybePrecision MaybeInt32Param
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&trim, From(VarExpr(t.Trim, vars), NonemptyString(t.Trim))), "trim"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if trim.Decimal().IsNegative() || trim.Decimal().GreaterThanOrEqual(decimal.NewFromFloat(0.5)) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "trim: must be at least 0 and less than 0.5, got %s", trim.Decimal())}, runInfo
+	}
+
+	values, err := resolveAggregationDecimals(t.Type(), t.Values, t.AllowedFaults, vars, inputs)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	k := int(trim.Decimal().Mul(decimal.NewFromInt(int64(len(values)))).IntPart())
+	values = values[k : len(values)-k]
+
+	total := decimal.Zero
+	for _, val := range values {
+		total = total.Add(val)
+	}
+	numValues := decimal.NewFromInt(int64(len(values)))
+
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		return Result{Value: total.DivRound(numValues, precision)}, runInfo
+	}
+	return Result{Value: total.Div(numValues)}, runInfo
+}
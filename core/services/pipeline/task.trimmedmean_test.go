@@ -0,0 +1,65 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestTrimmedMeanTask(t *testing.T) {
+	t.Parallel()
+
+	values := []pipeline.Result{{Value: 100}, {Value: 1}, {Value: 2}, {Value: 3}, {Value: 4}, {Value: -50}}
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		trim          string
+		precision     string
+		allowedFaults string
+		want          string
+		wantErr       error
+	}{
+		{"no trim", values, "0", "", "", "10", nil},
+		{"trim outliers", values, "0.2", "", "", "2.5", nil},
+		{"trim rounds down", values, "0.1", "", "", "10", nil},
+		{"precision", []pipeline.Result{{Value: 1}, {Value: 1}, {Value: 2}}, "0", "2", "", "1.33", nil},
+		{"errors within allowed faults", append([]pipeline.Result{{Error: errors.New("")}}, values...), "0.2", "", "1", "2.5", nil},
+		{"more errors than allowed faults", append([]pipeline.Result{{Error: errors.New("")}}, values...), "0.2", "", "0", "", pipeline.ErrTooManyErrors},
+		{"trim too large", values, "0.5", "", "", "", pipeline.ErrBadInput},
+		{"negative trim", values, "-0.1", "", "", "", pipeline.ErrBadInput},
+		{"no trim given", values, "", "", "", "", pipeline.ErrParameterEmpty},
+		{"zero inputs", []pipeline.Result{}, "0.1", "", "0", "", pipeline.ErrWrongInputCardinality},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.TrimmedMeanTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Trim:          test.trim,
+				Precision:     test.precision,
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+			require.Equal(t, test.want, output.Value.(decimal.Decimal).String())
+		})
+	}
+}
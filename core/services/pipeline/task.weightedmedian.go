@@ -0,0 +1,104 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// WeightedMedianTask returns the weighted median of its values, where `weights` is a list of non-negative weights
+// parallel to the values. It is the value at which the cumulative weight of the sorted values reaches half of the
+// total, or the mean of it and the next value if the cumulative weight is exactly half, so equal weights give the
+// median. Like MedianTask, it fails if more values are errors than allowedFaults, and the weights of errors are
+// ignored.
+//
+//	weighted_median [type="weightedmedian" weights="[3, 1, 1]"]
+//
+// Return types:
+//
+//	*decimal.Decimal
+type WeightedMedianTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Weights       string `json:"weights"`
+	AllowedFaults string `json:"allowedFaults"`
+}
+
+var _ Task = (*WeightedMedianTask)(nil)
+
+func (t *WeightedMedianTask) Type() TaskType {
+	return TaskTypeWeightedMedian
+}
+
+type weightedValue struct {
+	value  decimal.Decimal
+	weight decimal.Decimal
+}
+
+func (t *WeightedMedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var weights DecimalSliceParam
+	err := ResolveParam(&weights, From(VarExpr(t.Weights, vars), JSONWithVarExprs(t.Weights, vars, false)))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "weights")}, runInfo
+	}
+
+	valuesAndErrs, err := resolveAggregationValues(t.Type(), t.Values, t.AllowedFaults, vars, inputs)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if len(weights) != len(valuesAndErrs) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "weights: expected %d weights, got %d", len(valuesAndErrs), len(weights))}, runInfo
+	}
+
+	var (
+		weighted []weightedValue
+		total    decimal.Decimal
+	)
+	for i, v := range valuesAndErrs {
+		if _, isErr := v.(error); isErr {
+			continue
+		}
+		var value DecimalParam
+		if err = value.UnmarshalPipelineParam(v); err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "values: %v", err)}, runInfo
+		}
+		if weights[i].IsNegative() {
+			return Result{Error: errors.Wrapf(ErrBadInput, "weights: must not be negative, got %s", weights[i])}, runInfo
+		}
+		weighted = append(weighted, weightedValue{value.Decimal(), weights[i]})
+		total = total.Add(weights[i])
+	}
+	if len(weighted) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "values")}, runInfo
+	} else if total.IsZero() {
+		return Result{Error: errors.Wrap(ErrBadInput, "weights: total weight of values must be positive")}, runInfo
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].value.LessThan(weighted[j].value)
+	})
+
+	two := decimal.NewFromInt(2)
+	cumulative := decimal.Zero
+	for i, wv := range weighted {
+		cumulative = cumulative.Add(wv.weight)
+		if cumulative.Mul(two).LessThan(total) {
+			continue
+		}
+		if cumulative.Mul(two).Equal(total) {
+			// the median lies between this value and the next one with any weight
+			for _, next := range weighted[i+1:] {
+				if next.weight.IsPositive() {
+					return Result{Value: wv.value.Add(next.value).Div(two)}, runInfo
+				}
+			}
+		}
+		return Result{Value: wv.value}, runInfo
+	}
+	// unreachable, the cumulative weight reaches the total
+	return Result{Value: weighted[len(weighted)-1].value}, runInfo
+}
@@ -0,0 +1,65 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestWeightedMedianTask(t *testing.T) {
+	t.Parallel()
+
+	values := []pipeline.Result{{Value: 3}, {Value: 1}, {Value: 2}}
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		weights       string
+		allowedFaults string
+		want          string
+		wantErr       error
+	}{
+		{"equal weights", values, "[1, 1, 1]", "", "2", nil},
+		{"heavy value", values, "[1, 5, 1]", "", "1", nil},
+		{"exactly half", values, "[2, 1, 1]", "", "2.5", nil},
+		{"exactly half with zero weight between", []pipeline.Result{{Value: 1}, {Value: 2}, {Value: 3}}, "[1, 0, 1]", "", "2", nil},
+		{"weights from vars", values, "[$(weights.a), 1, 1]", "", "3", nil},
+		{"errors within allowed faults", append([]pipeline.Result{{Error: errors.New("")}}, values...), "[100, 1, 1, 1]", "1", "2", nil},
+		{"more errors than allowed faults", append([]pipeline.Result{{Error: errors.New("")}}, values...), "[100, 1, 1, 1]", "0", "", pipeline.ErrTooManyErrors},
+		{"wrong number of weights", values, "[1, 1]", "", "", pipeline.ErrBadInput},
+		{"negative weight", values, "[1, -1, 1]", "", "", pipeline.ErrBadInput},
+		{"zero total weight", values, "[0, 0, 0]", "", "", pipeline.ErrBadInput},
+		{"zero inputs", []pipeline.Result{}, "[]", "0", "", pipeline.ErrWrongInputCardinality},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.WeightedMedianTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Weights:       test.weights,
+				AllowedFaults: test.allowedFaults,
+			}
+			vars := pipeline.NewVarsFrom(map[string]interface{}{"weights": map[string]interface{}{"a": 5}})
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+			require.Equal(t, test.want, output.Value.(decimal.Decimal).String())
+		})
+	}
+}
@@ -444,6 +444,10 @@ func (u *URLParam) String() string {
 	return (*url.URL)(u).String()
 }
 
+func (u *URLParam) Hostname() string {
+	return (*url.URL)(u).Hostname()
+}
+
 type AddressParam common.Address
 
 func (a *AddressParam) UnmarshalPipelineParam(val interface{}) error {
@@ -0,0 +1,162 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+const (
+	// keywordForEach is the reserved ref under which a `forEach` step exposes
+	// the current element (`$(forEach.item)`) and its position (`$(forEach.index)`).
+	keywordForEach = "forEach"
+)
+
+// errStepSkipped is returned when a step's condition evaluated to false, or one of its
+// dependencies was skipped.
+var errStepSkipped = errors.New("step skipped")
+
+// conditionTokenRe matches every interpolation token within a condition expression.
+var conditionTokenRe = regexp.MustCompile(`\$\(([^\s)]+)\)`)
+
+// condition is a compiled step condition. Interpolation tokens in the
+// original expression are replaced by variables, which are resolved from
+// the execution state when the condition is evaluated.
+type condition struct {
+	program *vm.Program
+	// keys holds the interpolation key of each variable, indexed by variable name.
+	keys map[string]string
+}
+
+// compileCondition compiles a step's `condition` expression and returns the refs
+// of all steps the expression depends on.
+//
+// Step inputs and outputs are referenced the same way as in a step's inputs, e.g.
+//
+//	condition: $(evm_median.outputs.deviation) > 0.5
+func compileCondition(expression string) (*condition, []string, error) {
+	c := &condition{keys: map[string]string{}}
+	refs := []string{}
+	var parseErr error
+	rewritten := conditionTokenRe.ReplaceAllStringFunc(expression, func(token string) string {
+		key := conditionTokenRe.FindStringSubmatch(token)[1]
+		ref := strings.Split(key, ".")[0]
+		if ref == keywordForEach {
+			parseErr = fmt.Errorf("invalid condition `%s`: cannot reference `%s`", expression, keywordForEach)
+		}
+		refs = append(refs, ref)
+
+		name := fmt.Sprintf("_ref%d", len(c.keys))
+		c.keys[name] = key
+		return name
+	})
+	if parseErr != nil {
+		return nil, nil, parseErr
+	}
+
+	program, err := expr.Compile(rewritten, expr.AsBool())
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid condition `%s`: %w", expression, err)
+	}
+	c.program = program
+
+	return c, refs, nil
+}
+
+// evaluateCondition resolves the condition's references from `state` and runs it.
+func evaluateCondition(c *condition, state store.WorkflowExecution) (bool, error) {
+	env := make(map[string]any, len(c.keys))
+	for name, key := range c.keys {
+		val, err := interpolateKey(key, state)
+		if err != nil {
+			return false, err
+		}
+		env[name] = toExprValue(val)
+	}
+
+	result, err := expr.Run(c.program, env)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate condition: %w", err)
+	}
+
+	return result.(bool), nil
+}
+
+// toExprValue converts unwrapped values into types the expression
+// language can compare and do arithmetic on.
+func toExprValue(v any) any {
+	switch tv := v.(type) {
+	case decimal.Decimal:
+		f, _ := tv.Float64()
+		return f
+	case *big.Int:
+		if tv.IsInt64() {
+			return tv.Int64()
+		}
+		f, _ := new(big.Float).SetInt(tv).Float64()
+		return f
+	case map[string]any:
+		m := make(map[string]any, len(tv))
+		for k, el := range tv {
+			m[k] = toExprValue(el)
+		}
+		return m
+	case []any:
+		l := make([]any, len(tv))
+		for i, el := range tv {
+			l[i] = toExprValue(el)
+		}
+		return l
+	default:
+		return v
+	}
+}
+
+// forEachItems resolves the list a `forEach` step fans out over.
+func forEachItems(forEach string, state store.WorkflowExecution) ([]any, error) {
+	matches := interpolationTokenRe.FindStringSubmatch(forEach)
+	if len(matches) < 2 {
+		return nil, fmt.Errorf("invalid forEach `%s`: must be a reference of the form $(ref.outputs.path)", forEach)
+	}
+
+	val, err := interpolateKey(matches[1], state)
+	if err != nil {
+		return nil, err
+	}
+
+	items, ok := val.([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid forEach `%s`: expected a list, got %T", forEach, val)
+	}
+
+	return items, nil
+}
+
+// withForEachItem returns a copy of `state` exposing `item` under the `forEach` keyword.
+func withForEachItem(state store.WorkflowExecution, item any, index int) (store.WorkflowExecution, error) {
+	v, err := values.Wrap(map[string]any{
+		"item":  item,
+		"index": index,
+	})
+	if err != nil {
+		return store.WorkflowExecution{}, err
+	}
+
+	es := copyState(state)
+	es.Steps[keywordForEach] = &store.WorkflowExecutionStep{
+		ExecutionID: state.ExecutionID,
+		Ref:         keywordForEach,
+		Status:      store.StatusCompleted,
+		Outputs:     &store.StepOutput{Value: v},
+	}
+	return es, nil
+}
@@ -110,7 +110,9 @@ func NewDelegate(logger logger.Logger, registry core.CapabilitiesRegistry, legac
 	return &Delegate{logger: logger, registry: registry, legacyEVMChains: legacyEVMChains, store: store, peerID: peerID}
 }
 
-func ValidatedWorkflowSpec(tomlString string) (job.Job, error) {
+// ValidatedWorkflowSpec parses and validates a workflow job spec, including the workflow itself.
+// The workflow's capabilities are looked up in the registry to check that it matches their schemas.
+func ValidatedWorkflowSpec(ctx context.Context, tomlString string, registry core.CapabilitiesRegistry) (job.Job, error) {
 	var jb = job.Job{ExternalJobID: uuid.New()}
 
 	tree, err := toml.Load(tomlString)
@@ -133,6 +135,10 @@ func ValidatedWorkflowSpec(tomlString string) (job.Job, error) {
 		return jb, err
 	}
 
+	if err := ValidateWorkflow(ctx, spec.Workflow, registry); err != nil {
+		return jb, fmt.Errorf("invalid workflow: %w", err)
+	}
+
 	jb.WorkflowSpec = &spec
 	if jb.Type != job.Workflow {
 		return jb, fmt.Errorf("unsupported type %s", jb.Type)
@@ -3,8 +3,13 @@ package workflows_test
 import (
 	"testing"
 
+	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/require"
 
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
 )
 
@@ -23,9 +28,81 @@ type = "workflow"
 schemaVersion = 1
 workflowId = "15c631d295ef5e32deb99a10ee6804bc4af1385568f9b3363f6552ac6dbb2cef"
 workflowOwner = "00000000000000000000000000000000000000aa"
+workflow = """
+triggers:
+  - id: "mercury-trigger"
+    config:
+      feedIds:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000"
+consensus:
+  - id: "offchain_reporting"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+targets:
+  - id: "write_ethereum-testnet-sepolia"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x54e220867af6683aE6DcBF535B4f952cB5116510"
+"""
 `,
 			true,
 		},
+		{
+			"invalid workflow",
+			`
+type = "workflow"
+schemaVersion = 1
+workflowId = "15c631d295ef5e32deb99a10ee6804bc4af1385568f9b3363f6552ac6dbb2cef"
+workflowOwner = "00000000000000000000000000000000000000aa"
+workflow = """
+triggers:
+  - id: "mercury-trigger"
+    config: {}
+targets:
+  - id: "write_ethereum-testnet-sepolia"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config: {}
+"""
+`,
+			false,
+		},
+		{
+			"invalid trigger config",
+			`
+type = "workflow"
+schemaVersion = 1
+workflowId = "15c631d295ef5e32deb99a10ee6804bc4af1385568f9b3363f6552ac6dbb2cef"
+workflowOwner = "00000000000000000000000000000000000000aa"
+workflow = """
+triggers:
+  - id: "cron-trigger"
+    config:
+      interval: "1m"
+targets:
+  - id: "write_ethereum-testnet-sepolia"
+    inputs:
+      report: "$(trigger.outputs)"
+    config: {}
+"""
+`,
+			false,
+		},
+		{
+			"missing workflow",
+			`
+type = "workflow"
+schemaVersion = 1
+workflowId = "15c631d295ef5e32deb99a10ee6804bc4af1385568f9b3363f6552ac6dbb2cef"
+workflowOwner = "00000000000000000000000000000000000000aa"
+`,
+			false,
+		},
 		{
 			"parse error",
 			`
@@ -45,7 +122,9 @@ schemaVersion = 1
 	for _, tc := range tt {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := workflows.ValidatedWorkflowSpec(tc.toml)
+			registry := coreCap.NewRegistry(logger.TestLogger(t))
+			require.NoError(t, registry.Add(testutils.Context(t), triggers.NewCronTrigger(clockwork.NewFakeClock(), logger.TestLogger(t))))
+			_, err := workflows.ValidatedWorkflowSpec(testutils.Context(t), tc.toml, registry)
 			if tc.valid {
 				require.NoError(t, err)
 			} else {
@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/metadata"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
@@ -482,7 +485,7 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 	}
 
 	switch stepUpdate.Status {
-	case store.StatusCompleted:
+	case store.StatusCompleted, store.StatusSkipped:
 		stepDependents, err := e.workflow.dependents(stepUpdate.Ref)
 		if err != nil {
 			return err
@@ -504,7 +507,7 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 				}
 
 				switch step.Status {
-				case store.StatusCompleted, store.StatusErrored:
+				case store.StatusCompleted, store.StatusErrored, store.StatusTimeout, store.StatusSkipped:
 				default:
 					workflowCompleted = false
 				}
@@ -530,8 +533,8 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 		for _, sd := range stepDependents {
 			e.queueIfReady(state, sd)
 		}
-	case store.StatusErrored:
-		err := e.finishExecution(ctx, state.ExecutionID, store.StatusErrored)
+	case store.StatusErrored, store.StatusTimeout:
+		err := e.finishExecution(ctx, state.ExecutionID, stepUpdate.Status)
 		if err != nil {
 			return err
 		}
@@ -550,12 +553,14 @@ func (e *Engine) queueIfReady(state store.WorkflowExecution, step *step) {
 			continue
 		}
 
-		// Unless the dependency is complete,
+		// Unless the dependency is complete or skipped,
 		// we'll mark waitingOnDependencies = true.
 		// This includes cases where one of the dependent
 		// steps has errored, since that means we shouldn't
 		// schedule the step for execution.
-		if stepState.Status != store.StatusCompleted {
+		// Steps depending on a skipped step are scheduled
+		// so that they can be marked as skipped too.
+		if stepState.Status != store.StatusCompleted && stepState.Status != store.StatusSkipped {
 			waitingOnDependencies = true
 		}
 	}
@@ -596,8 +601,15 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 		Ref:         msg.stepRef,
 	}
 
-	inputs, outputs, err := e.executeStep(ctx, l, msg)
-	if err != nil {
+	inputs, outputs, err := e.executeStep(ctx, l, msg, stepState)
+	if errors.Is(err, errStepSkipped) {
+		l.Infow("step skipped")
+		stepState.Status = store.StatusSkipped
+	} else if errors.Is(err, errStepTimeout) {
+		l.Errorf("step timed out: %s", err)
+		stepState.Outputs.Err = err
+		stepState.Status = store.StatusTimeout
+	} else if err != nil {
 		l.Errorf("error executing step request: %s", err)
 		stepState.Outputs.Err = err
 		stepState.Status = store.StatusErrored
@@ -623,13 +635,76 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 }
 
 // executeStep executes the referenced capability within a step and returns the result.
-func (e *Engine) executeStep(ctx context.Context, l logger.Logger, msg stepRequest) (*values.Map, values.Value, error) {
+//
+// If the step's condition evaluates to false, or any of its dependencies was skipped,
+// errStepSkipped is returned. Steps with a `forEach` property execute the capability
+// once per element and return the list of outputs.
+//
+// Every attempt at executing the capability is recorded in `stepState`.
+func (e *Engine) executeStep(ctx context.Context, l logger.Logger, msg stepRequest, stepState *store.WorkflowExecutionStep) (*values.Map, values.Value, error) {
 	step, err := e.workflow.Vertex(msg.stepRef)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	i, err := findAndInterpolateAllKeys(step.Inputs, msg.state)
+	for _, dr := range step.dependencies {
+		if ds, ok := msg.state.Steps[dr]; ok && ds.Status == store.StatusSkipped {
+			return nil, nil, errStepSkipped
+		}
+	}
+
+	if step.condition != nil {
+		ok, err := evaluateCondition(step.condition, msg.state)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !ok {
+			return nil, nil, errStepSkipped
+		}
+	}
+
+	if step.ForEach == "" {
+		return e.executeCapability(ctx, l, step, msg.state, -1, stepState)
+	}
+
+	items, err := forEachItems(step.ForEach, msg.state)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allInputs := make([]any, 0, len(items))
+	allOutputs := make([]values.Value, 0, len(items))
+	for i, item := range items {
+		state, err := withForEachItem(msg.state, item, i)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		inputs, output, err := e.executeCapability(ctx, l.With("forEachIndex", i), step, state, i, stepState)
+		if inputs != nil {
+			allInputs = append(allInputs, inputs)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("forEach element %d: %w", i, err)
+		}
+
+		allOutputs = append(allOutputs, output)
+	}
+
+	inputs, err := values.NewMap(map[string]any{keywordForEach: allInputs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return inputs, &values.List{Underlying: allOutputs}, nil
+}
+
+// executeCapability interpolates the step's inputs from `state` and executes its capability,
+// retrying failed attempts according to the step's retry policy. `forEachIndex` is the index
+// of the executed forEach element, or -1 for steps without a forEach list.
+func (e *Engine) executeCapability(ctx context.Context, l logger.Logger, step *step, state store.WorkflowExecution, forEachIndex int, stepState *store.WorkflowExecutionStep) (*values.Map, values.Value, error) {
+	i, err := findAndInterpolateAllKeys(step.Inputs, state)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -641,19 +716,80 @@ func (e *Engine) executeStep(ctx context.Context, l logger.Logger, msg stepReque
 
 	tr := capabilities.CapabilityRequest{
 		Inputs: inputs,
-		Config: step.config,
 		Metadata: capabilities.RequestMetadata{
-			WorkflowID:          msg.state.WorkflowID,
-			WorkflowExecutionID: msg.state.ExecutionID,
+			WorkflowID:          state.WorkflowID,
+			WorkflowExecutionID: state.ExecutionID,
 		},
 	}
 
-	output, err := step.executionStrategy.Apply(ctx, l, step.capability, tr)
+	rp := step.retryPolicy
+	for retry := 0; ; retry++ {
+		if retry > 0 {
+			delay := rp.delay(retry)
+			l.Infow("retrying step", "retry", retry, "maxRetries", rp.maxRetries, "delay", delay)
+			t := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return inputs, nil, ctx.Err()
+			case <-t.C:
+			}
+		}
+
+		// Each attempt is a separate request, so that capabilities don't mistake a retry for a duplicate.
+		tr.Config = metadata.WithRequestID(step.config, metadata.NewRequestID(state.ExecutionID, step.Ref, forEachIndex, retry))
+		output, err := e.executeAttempt(ctx, l, step, tr, stepState)
+		if err == nil {
+			return inputs, output, nil
+		}
+
+		if retry >= rp.maxRetries || ctx.Err() != nil {
+			return inputs, nil, err
+		}
+		l.Errorw("step attempt failed", "retry", retry, "err", err)
+	}
+}
+
+// executeAttempt executes the step's capability once, bounded by the step's timeout if it has one,
+// and records the attempt in `stepState`. The attempt is persisted right away, so that the attempts
+// of a step that's still running, or was interrupted, are visible in the execution's history.
+func (e *Engine) executeAttempt(ctx context.Context, l logger.Logger, step *step, req capabilities.CapabilityRequest, stepState *store.WorkflowExecutionStep) (values.Value, error) {
+	attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+	if step.retryPolicy.timeout > 0 {
+		attemptCtx, cancel = context.WithTimeout(ctx, step.retryPolicy.timeout)
+	}
+	defer cancel()
+
+	attempt := store.StepAttempt{StartedAt: e.clock.Now()}
+	output, err := step.executionStrategy.Apply(attemptCtx, l, step.capability, req)
+	attempt.FinishedAt = e.clock.Now()
+
+	// Only attribute the failure to the step's timeout if the execution itself wasn't cancelled.
+	if err != nil && ctx.Err() == nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("%w after %s: %w", errStepTimeout, step.retryPolicy.timeout, err)
+	}
 	if err != nil {
-		return inputs, nil, err
+		attempt.Err = err.Error()
 	}
 
-	return inputs, output, err
+	stepState.Attempts = append(stepState.Attempts, attempt)
+	e.saveAttempts(ctx, l, stepState)
+	return output, err
+}
+
+// saveAttempts persists the attempts recorded so far for a step that's still running.
+// The final state of the step is persisted once it finishes, via a step update.
+func (e *Engine) saveAttempts(ctx context.Context, l logger.Logger, stepState *store.WorkflowExecutionStep) {
+	_, err := e.executionStates.UpsertStep(ctx, &store.WorkflowExecutionStep{
+		ExecutionID: stepState.ExecutionID,
+		Ref:         stepState.Ref,
+		Status:      store.StatusStarted,
+		Outputs:     &store.StepOutput{},
+		Attempts:    slices.Clone(stepState.Attempts),
+	})
+	if err != nil {
+		l.Errorw("failed to save step attempts", "err", err)
+	}
 }
 
 func (e *Engine) deregisterTrigger(ctx context.Context, t *triggerCapability) error {
@@ -800,10 +936,9 @@ func NewEngine(cfg Config) (engine *Engine, err error) {
 		cfg.clock = clockwork.NewRealClock()
 	}
 
-	// TODO: validation of the workflow spec
+	// NOTE: workflow specs are validated against the capabilities' schemas when the job is created, see ValidateWorkflow.
+	// TODO: further validation of the workflow spec
 	// We'll need to check, among other things:
-	// - that there are no step `ref` called `trigger` as this is reserved for any triggers
-	// - that there are no duplicate `ref`s
 	// - that the `ref` for any triggers is empty -- and filled in with `trigger`
 	// - that the resulting graph is strongly connected (i.e. no disjointed subgraphs exist)
 	// - etc.
@@ -3,6 +3,7 @@ package workflows
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/metadata"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -205,6 +207,7 @@ func TestEngineWithHardcodedWorkflow(t *testing.T) {
 			target1 := mockTarget()
 			require.NoError(t, reg.Add(ctx, target1))
 
+			var target2RequestID string
 			target2 := newMockCapability(
 				capabilities.MustNewCapabilityInfo(
 					"write_ethereum-testnet-sepolia",
@@ -214,6 +217,7 @@ func TestEngineWithHardcodedWorkflow(t *testing.T) {
 					nil,
 				),
 				func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+					target2RequestID = metadata.RequestID(req)
 					m := req.Inputs.Underlying["report"].(*values.Map)
 					return capabilities.CapabilityResponse{
 						Value: m,
@@ -236,6 +240,7 @@ func TestEngineWithHardcodedWorkflow(t *testing.T) {
 			eid := getExecutionId(t, eng, testHooks)
 			assert.Equal(t, cr, <-target1.response)
 			assert.Equal(t, cr, <-target2.response)
+			assert.Equal(t, metadata.NewRequestID(eid, "write_ethereum-testnet-sepolia", -1, 0), target2RequestID)
 
 			state, err := eng.executionStates.Get(ctx, eid)
 			require.NoError(t, err)
@@ -636,3 +641,309 @@ func TestEngine_TimesOutOldExecutions(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, store.StatusTimeout, gotEx.Status)
 }
+
+const (
+	conditionalWorkflow = `
+triggers:
+  - id: "mercury-trigger"
+    config:
+      feedlist:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000" # ETHUSD
+
+consensus:
+  - id: "offchain_reporting"
+    ref: "evm_median"
+    condition: $(trigger.outputs.123) > 10
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+
+targets:
+  - id: "write_polygon-testnet-mumbai"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+      params: ["$(report)"]
+      abi: "receive(report bytes)"
+`
+)
+
+func TestEngine_ConditionSkipsStepAndDependents(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus()))
+	target := mockTarget()
+	require.NoError(t, reg.Add(ctx, target))
+
+	eng, hooks := newTestEngine(t, reg, conditionalWorkflow)
+	err := eng.Start(ctx)
+	require.NoError(t, err)
+	defer eng.Close()
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	assert.Equal(t, store.StatusSkipped, state.Steps["evm_median"].Status)
+	assert.Equal(t, store.StatusSkipped, state.Steps["write_polygon-testnet-mumbai"].Status)
+	assert.Empty(t, target.response)
+}
+
+const (
+	forEachWorkflow = `
+triggers:
+  - id: "mercury-trigger"
+    config:
+      feedlist:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000" # ETHUSD
+
+consensus:
+  - id: "offchain_reporting"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+
+targets:
+  - id: "write_polygon-testnet-mumbai"
+    forEach: "$(evm_median.outputs.reports)"
+    inputs:
+      report: "$(forEach.item)"
+      index: "$(forEach.index)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+      params: ["$(report)"]
+      abi: "receive(report bytes)"
+`
+)
+
+func TestEngine_ForEach(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, cr := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+
+	consensus := newMockCapability(
+		capabilities.MustNewCapabilityInfo(
+			"offchain_reporting",
+			capabilities.CapabilityTypeConsensus,
+			"an ocr3 consensus capability",
+			"v3.0.0",
+			nil,
+		),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			rv, err := values.NewMap(map[string]any{
+				"reports": req.Inputs.Underlying["observations"],
+			})
+			if err != nil {
+				return capabilities.CapabilityResponse{}, err
+			}
+			return capabilities.CapabilityResponse{Value: rv}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, consensus))
+
+	var requestIDs []string
+	target := newMockCapability(
+		capabilities.MustNewCapabilityInfo(
+			"write_polygon-testnet-mumbai",
+			capabilities.CapabilityTypeTarget,
+			"a write capability targeting polygon mumbai testnet",
+			"v1.0.0",
+			nil,
+		),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			requestIDs = append(requestIDs, metadata.RequestID(req))
+			return capabilities.CapabilityResponse{Value: req.Inputs.Underlying["index"]}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, target))
+
+	eng, hooks := newTestEngine(t, reg, forEachWorkflow)
+	err := eng.Start(ctx)
+	require.NoError(t, err)
+	defer eng.Close()
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	assert.Len(t, target.response, 2)
+	// each element is a separate request to the capability
+	assert.Equal(t, []string{
+		metadata.NewRequestID(eid, "write_polygon-testnet-mumbai", 0, 0),
+		metadata.NewRequestID(eid, "write_polygon-testnet-mumbai", 1, 0),
+	}, requestIDs)
+
+	ts := state.Steps["write_polygon-testnet-mumbai"]
+	outputs, err := values.Unwrap(ts.Outputs.Value)
+	require.NoError(t, err)
+	assert.Equal(t, []any{int64(0), int64(1)}, outputs)
+
+	inputs, err := values.Unwrap(ts.Inputs)
+	require.NoError(t, err)
+	tunw, err := values.Unwrap(cr.Value)
+	require.NoError(t, err)
+	for i, in := range inputs.(map[string]any)[keywordForEach].([]any) {
+		assert.Equal(t, tunw, in.(map[string]any)["report"])
+		assert.Equal(t, int64(i), in.(map[string]any)["index"])
+	}
+}
+
+const (
+	retryWorkflow = `
+triggers:
+  - id: "mercury-trigger"
+    config:
+      feedlist:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000" # ETHUSD
+
+consensus:
+  - id: "offchain_reporting"
+    ref: "evm_median"
+    timeout: 100ms
+    maxRetries: 2
+    backoff:
+      policy: constant
+      interval: 10ms
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+
+targets:
+  - id: "write_polygon-testnet-mumbai"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+      params: ["$(report)"]
+      abi: "receive(report bytes)"
+`
+)
+
+func TestEngine_RetriesFailedStep(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+
+	var (
+		eng        *Engine
+		calls      atomic.Int32
+		requestIDs []string
+		// state of the step saved before its last attempt
+		savedStep *store.WorkflowExecutionStep
+	)
+	consensus := newMockCapability(
+		capabilities.MustNewCapabilityInfo(
+			"offchain_reporting",
+			capabilities.CapabilityTypeConsensus,
+			"an ocr3 consensus capability",
+			"v3.0.0",
+			nil,
+		),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			requestIDs = append(requestIDs, metadata.RequestID(req))
+			if calls.Add(1) < 3 {
+				return capabilities.CapabilityResponse{}, errors.New("transient consensus error")
+			}
+			state, err := eng.executionStates.Get(ctx, req.Metadata.WorkflowExecutionID)
+			if err != nil {
+				return capabilities.CapabilityResponse{}, err
+			}
+			savedStep = state.Steps["evm_median"]
+			obs := req.Inputs.Underlying["observations"].(*values.List)
+			rv, err := values.NewMap(map[string]any{"report": obs.Underlying[0]})
+			if err != nil {
+				return capabilities.CapabilityResponse{}, err
+			}
+			return capabilities.CapabilityResponse{Value: rv}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, consensus))
+	require.NoError(t, reg.Add(ctx, mockTarget()))
+
+	eng, hooks := newTestEngine(t, reg, retryWorkflow)
+	err := eng.Start(ctx)
+	require.NoError(t, err)
+	defer eng.Close()
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	attempts := state.Steps["evm_median"].Attempts
+	require.Len(t, attempts, 3)
+	assert.Contains(t, attempts[0].Err, "transient consensus error")
+	assert.Contains(t, attempts[1].Err, "transient consensus error")
+	assert.Empty(t, attempts[2].Err)
+
+	// every attempt is a separate request
+	assert.Equal(t, []string{
+		metadata.NewRequestID(eid, "evm_median", -1, 0),
+		metadata.NewRequestID(eid, "evm_median", -1, 1),
+		metadata.NewRequestID(eid, "evm_median", -1, 2),
+	}, requestIDs)
+
+	// failed attempts were saved while the step was still running
+	require.NotNil(t, savedStep)
+	assert.Equal(t, store.StatusStarted, savedStep.Status)
+	assert.Equal(t, attempts[:2], savedStep.Attempts)
+}
+
+// hangingCapability never responds, so its executions only end once their context is done.
+type hangingCapability struct {
+	*mockCapability
+}
+
+func (h hangingCapability) Execute(ctx context.Context, req capabilities.CapabilityRequest) (<-chan capabilities.CapabilityResponse, error) {
+	return make(chan capabilities.CapabilityResponse), nil
+}
+
+func TestEngine_TimesOutSlowStep(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, hangingCapability{mockConsensus()}))
+	target := mockTarget()
+	require.NoError(t, reg.Add(ctx, target))
+
+	eng, hooks := newTestEngine(t, reg, retryWorkflow)
+	err := eng.Start(ctx)
+	require.NoError(t, err)
+	defer eng.Close()
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusTimeout, state.Status)
+	step := state.Steps["evm_median"]
+	assert.Equal(t, store.StatusTimeout, step.Status)
+	assert.ErrorIs(t, step.Outputs.Err, errStepTimeout)
+	assert.Len(t, step.Attempts, 3)
+	assert.Empty(t, target.response)
+}
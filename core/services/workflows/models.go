@@ -25,6 +25,13 @@ type stepDefinition struct {
 	Inputs map[string]any `json:"inputs,omitempty"`
 	Config map[string]any `json:"config" jsonschema:"required"`
 
+	Condition string `json:"condition,omitempty"`
+	ForEach   string `json:"forEach,omitempty"`
+
+	Timeout    string             `json:"timeout,omitempty"`
+	MaxRetries int                `json:"maxRetries,omitempty"`
+	Backoff    *backoffDefinition `json:"backoff,omitempty"`
+
 	CapabilityType capabilities.CapabilityType `json:"-"`
 }
 
@@ -110,6 +117,8 @@ func (w *workflow) dependents(start string) ([]*step, error) {
 type step struct {
 	stepDefinition
 	dependencies      []string
+	condition         *condition
+	retryPolicy       retryPolicy
 	capability        capabilities.CallbackCapability
 	config            *values.Map
 	executionStrategy executionStrategy
@@ -163,7 +172,14 @@ func Parse(yamlWorkflow string) (*workflow, error) {
 			s.Ref = s.ID
 		}
 
+		if s.Ref == keywordTrigger {
+			return nil, fmt.Errorf("step %s: ref `%s` is reserved for triggers", s.ID, keywordTrigger)
+		}
+
 		innerErr := g.AddVertex(&step{stepDefinition: s})
+		if errors.Is(innerErr, graph.ErrVertexAlreadyExists) {
+			return nil, fmt.Errorf("duplicate step ref %s", s.Ref)
+		}
 		if innerErr != nil {
 			return nil, fmt.Errorf("cannot add vertex %s: %w", s.Ref, innerErr)
 		}
@@ -186,6 +202,34 @@ func Parse(yamlWorkflow string) (*workflow, error) {
 		if innerErr != nil {
 			return nil, innerErr
 		}
+
+		if step.ForEach != "" {
+			forEachRefs, innerErr := findRefs(map[string]any{"forEach": step.ForEach})
+			if innerErr != nil {
+				return nil, innerErr
+			}
+			if len(forEachRefs) == 0 {
+				return nil, fmt.Errorf("step %s: forEach must reference the outputs of another step", step.Ref)
+			}
+			refs = append(refs, forEachRefs...)
+		}
+
+		if step.Condition != "" {
+			cond, conditionRefs, innerErr := compileCondition(step.Condition)
+			if innerErr != nil {
+				return nil, fmt.Errorf("step %s: %w", step.Ref, innerErr)
+			}
+			step.condition = cond
+			refs = append(refs, conditionRefs...)
+		}
+
+		rp, innerErr := newRetryPolicy(step.stepDefinition)
+		if innerErr != nil {
+			return nil, fmt.Errorf("step %s: %w", step.Ref, innerErr)
+		}
+		step.retryPolicy = rp
+
+		refs = uniqueRefs(refs)
 		step.dependencies = refs
 
 		if stepRef != keywordTrigger && len(refs) == 0 {
@@ -195,7 +239,7 @@ func Parse(yamlWorkflow string) (*workflow, error) {
 		for _, r := range refs {
 			innerErr = g.AddEdge(r, step.Ref)
 			if innerErr != nil {
-				return nil, innerErr
+				return nil, fmt.Errorf("step %s: invalid dependency on step %s: %w", step.Ref, r, innerErr)
 			}
 		}
 	}
@@ -213,3 +257,16 @@ func Parse(yamlWorkflow string) (*workflow, error) {
 	}
 	return wf, err
 }
+
+// uniqueRefs removes duplicate refs while preserving their order.
+func uniqueRefs(refs []string) []string {
+	seen := map[string]bool{}
+	unique := []string{}
+	for _, r := range refs {
+		if !seen[r] {
+			seen[r] = true
+			unique = append(unique, r)
+		}
+	}
+	return unique
+}
@@ -212,6 +212,126 @@ targets:
 `,
 			errMsg: "all non-trigger steps must have a dependent ref",
 		},
+		{
+			name: "condition and forEach add dependencies",
+			yaml: `
+triggers:
+  - id: "a-trigger"
+
+actions:
+  - id: "an-action"
+    ref: "an-action"
+    inputs:
+      trigger_output: $(trigger.outputs)
+
+consensus:
+  - id: "a-consensus"
+    ref: "a-consensus"
+    condition: $(an-action.outputs.price) > 0
+    inputs:
+      trigger_output: $(trigger.outputs)
+
+targets:
+  - id: "a-target"
+    ref: "a-target"
+    forEach: $(a-consensus.outputs.reports)
+    inputs:
+      report: $(forEach.item)
+`,
+			graph: map[string]map[string]struct{}{
+				keywordTrigger: {
+					"an-action":   struct{}{},
+					"a-consensus": struct{}{},
+				},
+				"an-action": {
+					"a-consensus": struct{}{},
+				},
+				"a-consensus": {
+					"a-target": struct{}{},
+				},
+				"a-target": {},
+			},
+		},
+		{
+			name: "invalid condition",
+			yaml: `
+triggers:
+  - id: "a-trigger"
+
+actions:
+  - id: "an-action"
+    ref: "an-action"
+    condition: $(trigger.outputs.price) >
+    inputs:
+      trigger_output: $(trigger.outputs)
+`,
+			errMsg: "invalid condition",
+		},
+		{
+			name: "forEach without a ref",
+			yaml: `
+triggers:
+  - id: "a-trigger"
+
+actions:
+  - id: "an-action"
+    ref: "an-action"
+    forEach: "not-a-ref"
+    inputs:
+      trigger_output: $(trigger.outputs)
+`,
+			errMsg: "forEach must reference the outputs of another step",
+		},
+		{
+			name: "invalid timeout",
+			yaml: `
+triggers:
+  - id: "a-trigger"
+
+actions:
+  - id: "an-action"
+    ref: "an-action"
+    timeout: "ten seconds"
+    inputs:
+      trigger_output: $(trigger.outputs)
+`,
+			errMsg: "step an-action: invalid timeout",
+		},
+		{
+			name: "unknown backoff policy",
+			yaml: `
+triggers:
+  - id: "a-trigger"
+
+actions:
+  - id: "an-action"
+    ref: "an-action"
+    maxRetries: 3
+    backoff:
+      policy: "linear"
+    inputs:
+      trigger_output: $(trigger.outputs)
+`,
+			errMsg: "unknown backoff policy",
+		},
+		{
+			name: "backoff maxInterval less than interval",
+			yaml: `
+triggers:
+  - id: "a-trigger"
+
+actions:
+  - id: "an-action"
+    ref: "an-action"
+    maxRetries: 3
+    backoff:
+      interval: 10s
+      maxInterval: 1s
+    inputs:
+      trigger_output: $(trigger.outputs)
+`,
+			errMsg: "must not be less than interval",
+		},
 	}
 
 	for _, tc := range testCases {
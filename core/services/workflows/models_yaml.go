@@ -242,6 +242,52 @@ type stepDefinitionYaml struct {
 	//        method: "updateFeedValues(report bytes, role uint8)"
 	//        params: [$(inputs.report), 1]
 	Config mapping `json:"config" jsonschema:"required"`
+
+	// Capabilities can specify an optional “condition” property. It is an expression over the inputs and outputs of prior steps, which are referenced using the same $(ref.outputs.path) syntax as in “inputs”. If the condition evaluates to false, the step and all steps depending on it are skipped.
+	//
+	// Example
+	//  targets:
+	//    - id: write_polygon_mainnet@1
+	//      condition: $(evm_median.outputs.deviation) > 0.5
+	Condition string `json:"condition,omitempty"`
+
+	// Capabilities can specify an optional “forEach” property referencing a list output of a prior step. The step is then executed once per element, with the current element available as $(forEach.item) and its position as $(forEach.index). The outputs of the step are the list of outputs of each execution.
+	//
+	// Example
+	//  actions:
+	//    - id: fetch_price@1
+	//      ref: fetch_prices
+	//      forEach: $(trigger.outputs.feeds)
+	//      inputs:
+	//        feedId: $(forEach.item.feedId)
+	ForEach string `json:"forEach,omitempty"`
+
+	// Capabilities can specify an optional “timeout” property, bounding how long a single attempt at executing the capability may take. It uses Go duration syntax. A step whose last attempt times out fails the workflow execution with a timeout status.
+	//
+	// Example
+	//  actions:
+	//    - id: fetch_price@1
+	//      ref: fetch_price
+	//      timeout: 30s
+	Timeout string `json:"timeout,omitempty" jsonschema:"pattern=^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"`
+
+	// Capabilities can specify an optional “maxRetries” property. A failed or timed out attempt is retried up to this many times before the step errors. Defaults to 0, i.e. no retries.
+	MaxRetries int `json:"maxRetries,omitempty" jsonschema:"minimum=0"`
+
+	// Capabilities can specify an optional “backoff” property to control the delay between retries.
+	//
+	// The “policy” is either “constant” or “exponential” (the default). The exponential policy doubles the delay after each retry, starting at “interval” (default 1s) and capped at “maxInterval” (default 1m).
+	//
+	// Example
+	//  actions:
+	//    - id: fetch_price@1
+	//      ref: fetch_price
+	//      maxRetries: 3
+	//      backoff:
+	//        policy: exponential
+	//        interval: 500ms
+	//        maxInterval: 10s
+	Backoff *backoffDefinition `json:"backoff,omitempty"`
 }
 
 // toStepDefinition converts a stepDefinitionYaml to a stepDefinition.
@@ -249,10 +295,15 @@ type stepDefinitionYaml struct {
 // `stepDefinition` is the converged representation of a step in a workflow.
 func (s stepDefinitionYaml) toStepDefinition() stepDefinition {
 	return stepDefinition{
-		Ref:    s.Ref,
-		ID:     s.ID.String(),
-		Inputs: s.Inputs,
-		Config: s.Config,
+		Ref:        s.Ref,
+		ID:         s.ID.String(),
+		Inputs:     s.Inputs,
+		Config:     s.Config,
+		Condition:  s.Condition,
+		ForEach:    s.ForEach,
+		Timeout:    s.Timeout,
+		MaxRetries: s.MaxRetries,
+		Backoff:    s.Backoff,
 	}
 }
 
@@ -0,0 +1,132 @@
+package workflows
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commonutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ReaperConfig controls the retention of finished workflow executions.
+type ReaperConfig interface {
+	ReaperInterval() time.Duration
+	ReaperThreshold() time.Duration
+	ReaperMaxExecutions() uint32
+}
+
+// Reaper periodically deletes finished workflow executions that are older than
+// the configured threshold, or that exceed the configured number of executions
+// retained per workflow.
+type Reaper struct {
+	services.StateMachine
+	store  store.Store
+	config ReaperConfig
+	clock  clockwork.Clock
+	lggr   logger.Logger
+
+	reaperWorker *commonutils.SleeperTask
+
+	chStop services.StopChan
+	wgDone sync.WaitGroup
+}
+
+var _ services.Service = (*Reaper)(nil)
+
+func NewReaper(s store.Store, cfg ReaperConfig, clock clockwork.Clock, lggr logger.Logger) *Reaper {
+	r := &Reaper{
+		store:  s,
+		config: cfg,
+		clock:  clock,
+		lggr:   lggr.Named("WorkflowExecutionReaper"),
+		chStop: make(chan struct{}),
+	}
+	r.reaperWorker = commonutils.NewSleeperTask(
+		commonutils.SleeperFuncTask(r.runReaper, "WorkflowExecutionReaper"),
+	)
+	return r
+}
+
+func (r *Reaper) Start(context.Context) error {
+	return r.StartOnce("WorkflowExecutionReaper", func() error {
+		if r.config.ReaperInterval() != time.Duration(0) {
+			r.wgDone.Add(1)
+			go r.runReaperLoop()
+		}
+		return nil
+	})
+}
+
+func (r *Reaper) Close() error {
+	return r.StopOnce("WorkflowExecutionReaper", func() error {
+		close(r.chStop)
+		r.wgDone.Wait()
+		return r.reaperWorker.Stop()
+	})
+}
+
+func (r *Reaper) Name() string {
+	return r.lggr.Name()
+}
+
+func (r *Reaper) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
+
+func (r *Reaper) runReaperLoop() {
+	defer r.wgDone.Done()
+
+	// immediately reap so that executions which accumulated while the node was down are pruned
+	r.reaperWorker.WakeUp()
+
+	ticker := time.NewTicker(utils.WithJitter(r.config.ReaperInterval()))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.chStop:
+			return
+		case <-ticker.C:
+			r.reaperWorker.WakeUp()
+			ticker.Reset(utils.WithJitter(r.config.ReaperInterval()))
+		}
+	}
+}
+
+func (r *Reaper) runReaper() {
+	r.lggr.Debugw("Workflow execution reaper starting")
+	ctx, cancel := r.chStop.CtxCancel(context.WithTimeout(context.Background(), r.config.ReaperInterval()))
+	defer cancel()
+
+	if err := r.reap(ctx); err != nil {
+		r.lggr.Errorw("Workflow execution reaper failed", "err", err)
+		r.SvcErrBuffer.Append(err)
+	} else {
+		r.lggr.Debugw("Workflow execution reaper completed successfully")
+	}
+}
+
+func (r *Reaper) reap(ctx context.Context) error {
+	if threshold := r.config.ReaperThreshold(); threshold > 0 {
+		deleted, err := r.store.DeleteFinishedOlderThan(ctx, r.clock.Now().Add(-threshold))
+		if err != nil {
+			return err
+		}
+		r.lggr.Debugw("Deleted workflow executions older than threshold", "threshold", threshold, "deleted", deleted)
+	}
+
+	if maxExecutions := r.config.ReaperMaxExecutions(); maxExecutions > 0 {
+		deleted, err := r.store.DeleteFinishedAboveLimit(ctx, int(maxExecutions))
+		if err != nil {
+			return err
+		}
+		r.lggr.Debugw("Deleted workflow executions above per-workflow limit", "maxExecutions", maxExecutions, "deleted", deleted)
+	}
+	return nil
+}
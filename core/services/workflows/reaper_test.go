@@ -0,0 +1,95 @@
+package workflows
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+type testReaperConfig struct {
+	interval      time.Duration
+	threshold     time.Duration
+	maxExecutions uint32
+}
+
+func (c testReaperConfig) ReaperInterval() time.Duration  { return c.interval }
+func (c testReaperConfig) ReaperThreshold() time.Duration { return c.threshold }
+func (c testReaperConfig) ReaperMaxExecutions() uint32    { return c.maxExecutions }
+
+func addExecution(t *testing.T, s store.Store, clock clockwork.Clock, id, workflowID, status string) {
+	now := clock.Now()
+	es := &store.WorkflowExecution{
+		ExecutionID: id,
+		WorkflowID:  workflowID,
+		Status:      status,
+		Steps:       map[string]*store.WorkflowExecutionStep{},
+		CreatedAt:   &now,
+	}
+	if status != store.StatusStarted {
+		es.FinishedAt = &now
+	}
+	require.NoError(t, s.Add(tests.Context(t), es))
+}
+
+func TestReaper_Reap(t *testing.T) {
+	testCases := []struct {
+		name      string
+		config    testReaperConfig
+		remaining []string
+	}{
+		{
+			name:      "threshold",
+			config:    testReaperConfig{threshold: 90 * time.Minute},
+			remaining: []string{"a-2", "a-3"},
+		},
+		{
+			name:      "max executions",
+			config:    testReaperConfig{maxExecutions: 1},
+			remaining: []string{"a-2", "a-3", "b-1"},
+		},
+		{
+			name:      "threshold and max executions",
+			config:    testReaperConfig{threshold: 150 * time.Minute, maxExecutions: 2},
+			remaining: []string{"a-1", "a-2", "a-3", "b-1"},
+		},
+		{
+			name:      "disabled",
+			config:    testReaperConfig{},
+			remaining: []string{"a-0", "a-1", "a-2", "a-3", "b-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := clockwork.NewFakeClock()
+			s := store.NewInMemoryStore()
+
+			addExecution(t, s, clock, "a-0", "workflow-a", store.StatusCompleted)
+			clock.Advance(time.Hour)
+			addExecution(t, s, clock, "a-1", "workflow-a", store.StatusErrored)
+			addExecution(t, s, clock, "b-1", "workflow-b", store.StatusCompleted)
+			clock.Advance(time.Hour)
+			addExecution(t, s, clock, "a-2", "workflow-a", store.StatusCompleted)
+			clock.Advance(time.Hour)
+			addExecution(t, s, clock, "a-3", "workflow-a", store.StatusStarted)
+
+			r := NewReaper(s, tc.config, clock, logger.TestLogger(t))
+			require.NoError(t, r.reap(tests.Context(t)))
+
+			states, _, err := s.List(tests.Context(t), "", "", 0, 0)
+			require.NoError(t, err)
+			got := []string{}
+			for _, st := range states {
+				got = append(got, st.ExecutionID)
+			}
+			assert.ElementsMatch(t, tc.remaining, got)
+		})
+	}
+}
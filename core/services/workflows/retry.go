@@ -2,6 +2,7 @@ package workflows
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -51,3 +52,114 @@ func retryable(ctx context.Context, lggr logger.Logger, retryMs int, maxRetries
 		retries++
 	}
 }
+
+// errStepTimeout is returned when the last attempt at executing a step exceeded the step's timeout.
+var errStepTimeout = errors.New("step timed out")
+
+const (
+	backoffConstant    = "constant"
+	backoffExponential = "exponential"
+
+	defaultBackoffInterval    = time.Second
+	defaultBackoffMaxInterval = time.Minute
+)
+
+// backoffDefinition is the backoff policy of a step, as defined in the workflow spec.
+type backoffDefinition struct {
+	Policy      string `json:"policy,omitempty" jsonschema:"enum=constant,enum=exponential"`
+	Interval    string `json:"interval,omitempty"`
+	MaxInterval string `json:"maxInterval,omitempty"`
+}
+
+// retryPolicy controls how long a single attempt at executing a step's capability
+// may take, and how often and how quickly a failed attempt is retried.
+//
+// A zero timeout means attempts aren't bounded beyond the execution's context.
+type retryPolicy struct {
+	timeout     time.Duration
+	maxRetries  int
+	policy      string
+	interval    time.Duration
+	maxInterval time.Duration
+}
+
+// newRetryPolicy parses the `timeout`, `maxRetries` and `backoff` properties of a step.
+func newRetryPolicy(s stepDefinition) (retryPolicy, error) {
+	rp := retryPolicy{
+		maxRetries:  s.MaxRetries,
+		policy:      backoffExponential,
+		interval:    defaultBackoffInterval,
+		maxInterval: defaultBackoffMaxInterval,
+	}
+
+	if rp.maxRetries < 0 {
+		return retryPolicy{}, fmt.Errorf("maxRetries must not be negative, got %d", rp.maxRetries)
+	}
+
+	var err error
+	if s.Timeout != "" {
+		rp.timeout, err = parsePositiveDuration("timeout", s.Timeout)
+		if err != nil {
+			return retryPolicy{}, err
+		}
+	}
+
+	if s.Backoff == nil {
+		return rp, nil
+	}
+
+	switch s.Backoff.Policy {
+	case "":
+	case backoffConstant, backoffExponential:
+		rp.policy = s.Backoff.Policy
+	default:
+		return retryPolicy{}, fmt.Errorf("unknown backoff policy %q, must be one of %q or %q", s.Backoff.Policy, backoffConstant, backoffExponential)
+	}
+
+	if s.Backoff.Interval != "" {
+		rp.interval, err = parsePositiveDuration("backoff interval", s.Backoff.Interval)
+		if err != nil {
+			return retryPolicy{}, err
+		}
+	}
+
+	if s.Backoff.MaxInterval != "" {
+		rp.maxInterval, err = parsePositiveDuration("backoff maxInterval", s.Backoff.MaxInterval)
+		if err != nil {
+			return retryPolicy{}, err
+		}
+	}
+
+	if rp.maxInterval < rp.interval {
+		return retryPolicy{}, fmt.Errorf("backoff maxInterval %s must not be less than interval %s", rp.maxInterval, rp.interval)
+	}
+
+	return rp, nil
+}
+
+func parsePositiveDuration(name, s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid %s: must be positive, got %s", name, s)
+	}
+	return d, nil
+}
+
+// delay returns how long to wait before the given retry, starting at 1.
+func (r retryPolicy) delay(retry int) time.Duration {
+	if r.policy == backoffConstant {
+		return r.interval
+	}
+
+	d := r.interval
+	for i := 1; i < retry; i++ {
+		d *= 2
+		if d >= r.maxInterval {
+			return r.maxInterval
+		}
+	}
+	return d
+}
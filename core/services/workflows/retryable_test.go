@@ -111,3 +111,51 @@ func TestRetryableCancellationHandling(t *testing.T) {
 	err := retryable(ctx, logger.NullLogger, 100, 5, fn)
 	assert.ErrorIs(t, err, context.Canceled, "Expected context cancellation error")
 }
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults", func(t *testing.T) {
+		rp, err := newRetryPolicy(stepDefinition{})
+		require.NoError(t, err)
+		assert.Equal(t, time.Duration(0), rp.timeout)
+		assert.Equal(t, 0, rp.maxRetries)
+		assert.Equal(t, time.Second, rp.delay(1))
+		assert.Equal(t, 2*time.Second, rp.delay(2))
+	})
+
+	t.Run("exponential backoff is capped", func(t *testing.T) {
+		rp, err := newRetryPolicy(stepDefinition{
+			Timeout:    "30s",
+			MaxRetries: 10,
+			Backoff:    &backoffDefinition{Interval: "100ms", MaxInterval: "1s"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 30*time.Second, rp.timeout)
+		assert.Equal(t, 100*time.Millisecond, rp.delay(1))
+		assert.Equal(t, 200*time.Millisecond, rp.delay(2))
+		assert.Equal(t, 800*time.Millisecond, rp.delay(4))
+		assert.Equal(t, time.Second, rp.delay(5))
+		assert.Equal(t, time.Second, rp.delay(10))
+	})
+
+	t.Run("constant backoff", func(t *testing.T) {
+		rp, err := newRetryPolicy(stepDefinition{
+			MaxRetries: 3,
+			Backoff:    &backoffDefinition{Policy: backoffConstant, Interval: "250ms"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 250*time.Millisecond, rp.delay(1))
+		assert.Equal(t, 250*time.Millisecond, rp.delay(3))
+	})
+
+	t.Run("negative maxRetries", func(t *testing.T) {
+		_, err := newRetryPolicy(stepDefinition{MaxRetries: -1})
+		assert.ErrorContains(t, err, "maxRetries must not be negative")
+	})
+
+	t.Run("non-positive timeout", func(t *testing.T) {
+		_, err := newRetryPolicy(stepDefinition{Timeout: "0s"})
+		assert.ErrorContains(t, err, "must be positive")
+	})
+}
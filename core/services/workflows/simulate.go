@@ -0,0 +1,243 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dominikbraun/graph"
+	"github.com/jonboulle/clockwork"
+	"sigs.k8s.io/yaml"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+const (
+	simulationWorkflowID = "simulation"
+	simulationEventID    = "simulation"
+)
+
+// SimulationFixtures define the trigger event and the responses of the capabilities
+// used when simulating a workflow.
+type SimulationFixtures struct {
+	// Trigger is the payload of the trigger event which starts the simulated execution.
+	Trigger mapping `json:"trigger"`
+	// Capabilities maps the ID of each capability used by the workflow to its response.
+	Capabilities map[string]CapabilityFixture `json:"capabilities"`
+}
+
+// CapabilityFixture is the response returned by a mocked capability every time it is executed.
+type CapabilityFixture struct {
+	Outputs mapping `json:"outputs,omitempty"`
+	// Error, if set, is returned instead of the outputs.
+	Error string `json:"error,omitempty"`
+}
+
+// ParseSimulationFixtures parses simulation fixtures from YAML or JSON.
+func ParseSimulationFixtures(data string) (SimulationFixtures, error) {
+	f := SimulationFixtures{}
+	err := yaml.Unmarshal([]byte(data), &f)
+	return f, err
+}
+
+// SimulationResult is the outcome of a simulated workflow execution.
+type SimulationResult struct {
+	Execution store.WorkflowExecution
+	// StepRefs lists the refs of the workflow's steps in dependency order, starting with the trigger.
+	StepRefs []string
+}
+
+// Simulate runs a single execution of the workflow spec without deploying it.
+//
+// Every capability referenced by the spec is replaced by a mock returning the response from `fixtures`,
+// and the execution is kept in memory, so the simulation has no side effects. The simulation
+// ends once the execution finishes or `ctx` is done.
+func Simulate(ctx context.Context, lggr logger.Logger, spec string, fixtures SimulationFixtures) (SimulationResult, error) {
+	wf, err := Parse(spec)
+	if err != nil {
+		return SimulationResult{}, err
+	}
+
+	if len(wf.triggers) == 0 {
+		return SimulationResult{}, errors.New("workflow has no triggers")
+	}
+
+	stepRefs, err := graph.StableTopologicalSort(wf.Graph, func(a, b string) bool { return a < b })
+	if err != nil {
+		return SimulationResult{}, err
+	}
+
+	registry := coreCap.NewRegistry(lggr)
+	triggers := map[string]*fixtureTrigger{}
+	for _, t := range wf.triggers {
+		if _, ok := triggers[t.ID]; ok {
+			continue
+		}
+		ft := &fixtureTrigger{
+			CapabilityInfo: capabilities.MustNewCapabilityInfo(t.ID, capabilities.CapabilityTypeTrigger, "simulated trigger", "v1.0.0", nil),
+			ch:             make(chan capabilities.CapabilityResponse, 1),
+		}
+		if err = registry.Add(ctx, ft); err != nil {
+			return SimulationResult{}, err
+		}
+		triggers[t.ID] = ft
+	}
+
+	for _, s := range wf.spec.steps() {
+		if _, err = registry.Get(ctx, s.ID); err == nil {
+			continue
+		}
+		fixture, ok := fixtures.Capabilities[s.ID]
+		if !ok {
+			return SimulationResult{}, fmt.Errorf("no fixture defined for capability %s", s.ID)
+		}
+		fc := &fixtureCapability{
+			CapabilityInfo: capabilities.MustNewCapabilityInfo(s.ID, s.CapabilityType, "simulated capability", "v1.0.0", nil),
+			fixture:        fixture,
+		}
+		if err = registry.Add(ctx, fc); err != nil {
+			return SimulationResult{}, err
+		}
+	}
+
+	initialized := make(chan bool, 1)
+	finished := make(chan string, 1)
+	executionStore := store.NewInMemoryStore()
+	peerID := p2ptypes.PeerID{}
+	engine, err := NewEngine(Config{
+		Lggr:       lggr,
+		Spec:       spec,
+		WorkflowID: simulationWorkflowID,
+		Registry:   registry,
+		PeerID:     func() *p2ptypes.PeerID { return &peerID },
+		Store:      executionStore,
+		maxRetries: 1,
+		retryMs:    100,
+		afterInit:  func(success bool) { initialized <- success },
+		onExecutionFinished: func(executionID string) {
+			// only the first notification is of interest; never block the engine
+			select {
+			case finished <- executionID:
+			default:
+			}
+		},
+		clock: clockwork.NewRealClock(),
+	})
+	if err != nil {
+		return SimulationResult{}, err
+	}
+
+	if err = engine.Start(ctx); err != nil {
+		return SimulationResult{}, err
+	}
+	defer func() {
+		if cerr := engine.Close(); cerr != nil {
+			lggr.Errorw("failed to close simulated workflow engine", "err", cerr)
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return SimulationResult{}, ctx.Err()
+	case success := <-initialized:
+		if !success {
+			return SimulationResult{}, errors.New("failed to initialize simulated workflow")
+		}
+	}
+
+	event, err := newFixtureTriggerEvent(wf.triggers[0].ID, fixtures.Trigger)
+	if err != nil {
+		return SimulationResult{}, fmt.Errorf("invalid trigger fixture: %w", err)
+	}
+	triggers[wf.triggers[0].ID].ch <- event
+
+	select {
+	case <-ctx.Done():
+		return SimulationResult{}, ctx.Err()
+	case executionID := <-finished:
+		execution, err := executionStore.Get(ctx, executionID)
+		if err != nil {
+			return SimulationResult{}, err
+		}
+		return SimulationResult{Execution: execution, StepRefs: stepRefs}, nil
+	}
+}
+
+func newFixtureTriggerEvent(triggerType string, payload map[string]any) (capabilities.CapabilityResponse, error) {
+	p, err := values.NewMap(payload)
+	if err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+
+	event, err := values.Wrap(capabilities.TriggerEvent{
+		TriggerType: triggerType,
+		ID:          simulationEventID,
+		Timestamp:   strconv.FormatInt(time.Now().UnixMilli(), 10),
+		Payload:     p,
+	})
+	if err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+
+	return capabilities.CapabilityResponse{Value: event}, nil
+}
+
+// fixtureTrigger is a trigger capability which only emits the events sent to it by the simulation.
+type fixtureTrigger struct {
+	capabilities.CapabilityInfo
+	ch       chan capabilities.CapabilityResponse
+	stopOnce sync.Once
+}
+
+var _ capabilities.TriggerCapability = (*fixtureTrigger)(nil)
+
+func (f *fixtureTrigger) RegisterTrigger(ctx context.Context, req capabilities.CapabilityRequest) (<-chan capabilities.CapabilityResponse, error) {
+	return f.ch, nil
+}
+
+func (f *fixtureTrigger) UnregisterTrigger(ctx context.Context, req capabilities.CapabilityRequest) error {
+	f.stopOnce.Do(func() { close(f.ch) })
+	return nil
+}
+
+// fixtureCapability is an action, consensus or target capability which responds to
+// every request with its fixture.
+type fixtureCapability struct {
+	capabilities.CapabilityInfo
+	fixture CapabilityFixture
+}
+
+var _ capabilities.CallbackCapability = (*fixtureCapability)(nil)
+
+func (f *fixtureCapability) Execute(ctx context.Context, req capabilities.CapabilityRequest) (<-chan capabilities.CapabilityResponse, error) {
+	if f.fixture.Error != "" {
+		return nil, errors.New(f.fixture.Error)
+	}
+
+	outputs, err := values.NewMap(f.fixture.Outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan capabilities.CapabilityResponse, 1)
+	ch <- capabilities.CapabilityResponse{Value: outputs}
+	close(ch)
+	return ch, nil
+}
+
+func (f *fixtureCapability) RegisterToWorkflow(ctx context.Context, request capabilities.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (f *fixtureCapability) UnregisterFromWorkflow(ctx context.Context, request capabilities.UnregisterFromWorkflowRequest) error {
+	return nil
+}
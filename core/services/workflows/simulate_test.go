@@ -0,0 +1,66 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+const simulationFixtures = `
+trigger:
+  feedId: "0x1111111111111111111100000000000000000000000000000000000000000000"
+  price: 1.25
+capabilities:
+  offchain_reporting:
+    outputs:
+      report: "0xabcd"
+  write_polygon-testnet-mumbai: {}
+  write_ethereum-testnet-sepolia:
+    error: "transaction reverted"
+`
+
+func TestSimulate(t *testing.T) {
+	fixtures, err := ParseSimulationFixtures(simulationFixtures)
+	require.NoError(t, err)
+
+	result, err := Simulate(testutils.Context(t), logger.TestLogger(t), hardcodedWorkflow, fixtures)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"trigger", "evm_median", "write_ethereum-testnet-sepolia", "write_polygon-testnet-mumbai"}, result.StepRefs)
+
+	consensus := result.Execution.Steps["evm_median"]
+	require.NotNil(t, consensus)
+	assert.Equal(t, store.StatusCompleted, consensus.Status)
+
+	inputs, err := values.Unwrap(consensus.Inputs)
+	require.NoError(t, err)
+	observations := inputs.(map[string]any)["observations"].([]any)
+	require.Len(t, observations, 1)
+	assert.Equal(t, "mercury-trigger", observations[0].(map[string]any)["TriggerType"])
+
+	outputs, err := values.Unwrap(consensus.Outputs.Value)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"report": "0xabcd"}, outputs)
+
+	target := result.Execution.Steps["write_ethereum-testnet-sepolia"]
+	require.NotNil(t, target)
+	assert.Equal(t, store.StatusErrored, target.Status)
+	assert.ErrorContains(t, target.Outputs.Err, "transaction reverted")
+}
+
+func TestSimulate_MissingFixture(t *testing.T) {
+	fixtures, err := ParseSimulationFixtures(`
+capabilities:
+  offchain_reporting: {}
+`)
+	require.NoError(t, err)
+
+	_, err = Simulate(testutils.Context(t), logger.TestLogger(t), hardcodedWorkflow, fixtures)
+	assert.ErrorContains(t, err, "no fixture defined for capability write_polygon-testnet-mumbai")
+}
@@ -60,6 +60,11 @@ func copyState(es store.WorkflowExecution) store.WorkflowExecution {
 func interpolateKey(key string, state store.WorkflowExecution) (any, error) {
 	parts := strings.Split(key, ".")
 
+	// the `forEach` keyword exposes the current element directly, e.g. `forEach.item`
+	if parts[0] == keywordForEach {
+		parts = append([]string{keywordForEach, "outputs"}, parts[1:]...)
+	}
+
 	if len(parts) < 2 {
 		return "", fmt.Errorf("cannot interpolate %s: must have at least two parts", key)
 	}
@@ -164,7 +169,10 @@ func findRefs(inputs map[string]any) ([]string, error) {
 				return nil, fmt.Errorf("invalid ref %s", m)
 			}
 
-			refs = append(refs, parts[0])
+			// `forEach` is resolved by the step itself, not by another step
+			if parts[0] != keywordForEach {
+				refs = append(refs, parts[0])
+			}
 			return el, nil
 		},
 	)
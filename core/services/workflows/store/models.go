@@ -11,6 +11,7 @@ const (
 	StatusErrored   = "errored"
 	StatusTimeout   = "timeout"
 	StatusCompleted = "completed"
+	StatusSkipped   = "skipped"
 )
 
 type StepOutput struct {
@@ -18,6 +19,13 @@ type StepOutput struct {
 	Value values.Value
 }
 
+// StepAttempt records a single attempt at executing a step's capability.
+type StepAttempt struct {
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	Err        string    `json:"error,omitempty"`
+}
+
 type WorkflowExecutionStep struct {
 	ExecutionID string
 	Ref         string
@@ -26,6 +34,8 @@ type WorkflowExecutionStep struct {
 	Inputs  *values.Map
 	Outputs *StepOutput
 
+	Attempts []StepAttempt
+
 	UpdatedAt *time.Time
 }
 
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 )
 
 type Store interface {
@@ -10,6 +11,9 @@ type Store interface {
 	UpdateStatus(ctx context.Context, executionID string, status string) error
 	Get(ctx context.Context, executionID string) (WorkflowExecution, error)
 	GetUnfinished(ctx context.Context, offset, limit int) ([]WorkflowExecution, error)
+	List(ctx context.Context, workflowID string, status string, offset, limit int) ([]WorkflowExecution, int, error)
+	DeleteFinishedOlderThan(ctx context.Context, threshold time.Time) (int64, error)
+	DeleteFinishedAboveLimit(ctx context.Context, maxPerWorkflow int) (int64, error)
 }
 
 var _ Store = (*InMemoryStore)(nil)
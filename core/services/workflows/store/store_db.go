@@ -2,18 +2,23 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/proto"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 	valuespb "github.com/smartcontractkit/chainlink-common/pkg/values/pb"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
 // `DBStore` is a postgres-backed
@@ -44,6 +49,7 @@ type workflowStepRow struct {
 	Inputs              []byte
 	OutputErr           *string    `db:"output_err"`
 	OutputValue         []byte     `db:"output_value"`
+	Attempts            []byte     `db:"attempts"`
 	UpdatedAt           *time.Time `db:"updated_at"`
 }
 
@@ -147,6 +153,14 @@ func stepToState(step workflowStepRow) (*WorkflowExecutionStep, error) {
 		outputs = values.FromProto(vProto)
 	}
 
+	var attempts []StepAttempt
+	if len(step.Attempts) != 0 {
+		err := json.Unmarshal(step.Attempts, &attempts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var so *StepOutput
 	if outputErr != nil || outputs != nil {
 		so = &StepOutput{
@@ -161,6 +175,8 @@ func stepToState(step workflowStepRow) (*WorkflowExecutionStep, error) {
 		Status:      step.Status,
 		Inputs:      inputs,
 		Outputs:     so,
+		Attempts:    attempts,
+		UpdatedAt:   step.UpdatedAt,
 	}, nil
 }
 
@@ -182,6 +198,14 @@ func stateToStep(state *WorkflowExecutionStep) (workflowStepRow, error) {
 		Inputs:              inpb,
 	}
 
+	if len(state.Attempts) > 0 {
+		ab, err := json.Marshal(state.Attempts)
+		if err != nil {
+			return workflowStepRow{}, err
+		}
+		wsr.Attempts = ab
+	}
+
 	if state.Outputs == nil {
 		return wsr, nil
 	}
@@ -237,15 +261,15 @@ func (d *DBStore) Add(ctx context.Context, state *WorkflowExecution) error {
 }
 
 func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) error {
-	for _, s := range steps {
-		now := d.clock.Now()
-		s.UpdatedAt = &now
+	now := d.clock.Now()
+	for i := range steps {
+		steps[i].UpdatedAt = &now
 	}
 
 	sql := `
 	INSERT INTO
-	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, updated_at)
-	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :updated_at)
+	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, attempts, updated_at)
+	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :attempts, :updated_at)
 	ON CONFLICT ON CONSTRAINT uniq_workflow_execution_id_ref
 	DO UPDATE SET
 		workflow_execution_id = EXCLUDED.workflow_execution_id,
@@ -254,6 +278,7 @@ func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) erro
 		inputs = EXCLUDED.inputs,
 		output_err = EXCLUDED.output_err,
 		output_value = EXCLUDED.output_value,
+		attempts = EXCLUDED.attempts,
 		updated_at = EXCLUDED.updated_at;
 	`
 	stmt, args, err := sqlx.Named(sql, steps)
@@ -296,6 +321,7 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 		workflow_steps.inputs AS ws_inputs,
 		workflow_steps.output_err AS ws_output_err,
 		workflow_steps.output_value AS ws_output_value,
+		workflow_steps.attempts AS ws_attempts,
 		workflow_steps.updated_at AS ws_updated_at,
 		workflow_executions.id AS we_id,
 		workflow_executions.workflow_id AS we_workflow_id,
@@ -319,6 +345,7 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 		WSInputs              []byte     `db:"ws_inputs"`
 		WSOutputErr           *string    `db:"ws_output_err"`
 		WSOutputValue         []byte     `db:"ws_output_value"`
+		WSAttempts            []byte     `db:"ws_attempts"`
 		WSUpdatedAt           *time.Time `db:"ws_updated_at"`
 
 		// WorkflowExecution fields
@@ -359,6 +386,7 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 			OutputValue:         jr.WSOutputValue,
 			Inputs:              jr.WSInputs,
 			Status:              jr.WSStatus,
+			Attempts:            jr.WSAttempts,
 			UpdatedAt:           jr.WSUpdatedAt,
 		})
 		if err != nil {
@@ -377,6 +405,161 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 	return states, nil
 }
 
+// `List` returns a page of workflow executions, most recent first, along with the
+// total number of executions matching the filters. Empty `workflowID` and `status`
+// filters match all executions.
+func (d *DBStore) List(ctx context.Context, workflowID string, status string, offset, limit int) ([]WorkflowExecution, int, error) {
+	where := []string{}
+	args := []any{}
+	if workflowID != "" {
+		args = append(args, workflowID)
+		where = append(where, fmt.Sprintf("workflow_id = $%d", len(args)))
+	}
+	if status != "" {
+		args = append(args, status)
+		where = append(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+	filter := ""
+	if len(where) > 0 {
+		filter = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	var count int
+	err := d.db.GetContext(ctx, &count, `SELECT count(*) FROM workflow_executions `+filter, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	wexs := []workflowExecutionRow{}
+	sql := fmt.Sprintf(`SELECT * FROM workflow_executions %s ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d`, filter, len(args)+1, len(args)+2)
+	err = d.db.SelectContext(ctx, &wexs, sql, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]string, len(wexs))
+	for i, wex := range wexs {
+		ids[i] = wex.ID
+	}
+
+	ws := []workflowStepRow{}
+	err = d.db.SelectContext(ctx, &ws, `SELECT * FROM workflow_steps WHERE workflow_execution_id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	idToSteps := map[string]map[string]*WorkflowExecutionStep{}
+	for _, s := range ws {
+		ss, err := stepToState(s)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if _, ok := idToSteps[s.WorkflowExecutionID]; !ok {
+			idToSteps[s.WorkflowExecutionID] = map[string]*WorkflowExecutionStep{}
+		}
+		idToSteps[s.WorkflowExecutionID][s.Ref] = ss
+	}
+
+	states := []WorkflowExecution{}
+	for _, wex := range wexs {
+		var wid string
+		if wex.WorkflowID != nil {
+			wid = *wex.WorkflowID
+		}
+
+		steps, ok := idToSteps[wex.ID]
+		if !ok {
+			steps = map[string]*WorkflowExecutionStep{}
+		}
+
+		states = append(states, WorkflowExecution{
+			ExecutionID: wex.ID,
+			WorkflowID:  wid,
+			Status:      wex.Status,
+			Steps:       steps,
+			CreatedAt:   wex.CreatedAt,
+			UpdatedAt:   wex.UpdatedAt,
+			FinishedAt:  wex.FinishedAt,
+		})
+	}
+
+	return states, count, nil
+}
+
+// `DeleteFinishedOlderThan` deletes, in batches, finished workflow executions
+// and their steps whose `finished_at` is before the given threshold.
+func (d *DBStore) DeleteFinishedOlderThan(ctx context.Context, threshold time.Time) (int64, error) {
+	sql := `
+	SELECT id FROM workflow_executions
+	WHERE status != $1 AND finished_at < $2
+	ORDER BY finished_at ASC
+	LIMIT $3
+	`
+	return d.deleteInBatches(ctx, func(db *DBStore, limit uint) ([]string, error) {
+		ids := []string{}
+		err := db.db.SelectContext(ctx, &ids, sql, StatusStarted, threshold, limit)
+		return ids, err
+	})
+}
+
+// `DeleteFinishedAboveLimit` deletes, in batches, the oldest finished workflow
+// executions and their steps so that at most `maxPerWorkflow` finished executions
+// remain for each workflow.
+func (d *DBStore) DeleteFinishedAboveLimit(ctx context.Context, maxPerWorkflow int) (int64, error) {
+	sql := `
+	SELECT id FROM (
+		SELECT id, ROW_NUMBER() OVER (PARTITION BY workflow_id ORDER BY created_at DESC, id) AS row_num
+		FROM workflow_executions
+		WHERE status != $1
+	) ranked
+	WHERE row_num > $2
+	LIMIT $3
+	`
+	return d.deleteInBatches(ctx, func(db *DBStore, limit uint) ([]string, error) {
+		ids := []string{}
+		err := db.db.SelectContext(ctx, &ids, sql, StatusStarted, maxPerWorkflow, limit)
+		return ids, err
+	})
+}
+
+// `deleteInBatches` repeatedly selects a batch of execution ids using `selectIDs`
+// and deletes them, along with their steps, until a partial batch is returned.
+func (d *DBStore) deleteInBatches(ctx context.Context, selectIDs func(db *DBStore, limit uint) ([]string, error)) (int64, error) {
+	rowsDeleted := int64(0)
+	err := pg.Batch(func(_, limit uint) (count uint, err error) {
+		err = d.transact(ctx, func(db *DBStore) error {
+			ids, err := selectIDs(db, limit)
+			if err != nil {
+				return fmt.Errorf("could not select workflow executions to delete: %w", err)
+			}
+			count = uint(len(ids))
+			if count == 0 {
+				return nil
+			}
+
+			_, err = db.db.ExecContext(ctx, `DELETE FROM workflow_steps WHERE workflow_execution_id = ANY($1)`, pq.Array(ids))
+			if err != nil {
+				return fmt.Errorf("could not delete workflow steps: %w", err)
+			}
+
+			result, err := db.db.ExecContext(ctx, `DELETE FROM workflow_executions WHERE id = ANY($1)`, pq.Array(ids))
+			if err != nil {
+				return fmt.Errorf("could not delete workflow executions: %w", err)
+			}
+
+			rowsAffected, err := result.RowsAffected()
+			if err != nil {
+				return err
+			}
+			rowsDeleted += rowsAffected
+			return nil
+		})
+		return count, err
+	})
+	return rowsDeleted, err
+}
+
 func NewDBStore(ds sqlutil.DataSource, clock clockwork.Clock) *DBStore {
 	return &DBStore{db: ds, clock: clock}
 }
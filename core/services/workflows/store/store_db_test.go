@@ -2,11 +2,14 @@ package store
 
 import (
 	"crypto/rand"
+	"database/sql"
 	"encoding/hex"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -54,9 +57,19 @@ func Test_StoreDB(t *testing.T) {
 	// but is added by the db store.
 	gotEs.CreatedAt = nil
 	require.NoError(t, err)
+	zeroStepTimestamps(t, gotEs)
 	assert.Equal(t, es, gotEs)
 }
 
+// zeroStepTimestamps checks that the db store has set the updated at
+// timestamp of each step, then zeroes it out.
+func zeroStepTimestamps(t *testing.T, es WorkflowExecution) {
+	for _, s := range es.Steps {
+		require.NotNil(t, s.UpdatedAt)
+		s.UpdatedAt = nil
+	}
+}
+
 func Test_StoreDB_DuplicateEntry(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	store := &DBStore{db: db, clock: clockwork.NewFakeClock()}
@@ -154,10 +167,16 @@ func Test_StoreDB_UpdateStep(t *testing.T) {
 
 	stepOne.Inputs = nm
 	stepOne.Outputs = &StepOutput{Err: errors.New("some error")}
+	started := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	stepOne.Attempts = []StepAttempt{
+		{StartedAt: started, FinishedAt: started.Add(time.Second), Err: "some error"},
+		{StartedAt: started.Add(2 * time.Second), FinishedAt: started.Add(3 * time.Second), Err: "some error"},
+	}
 
 	es, err = store.UpsertStep(tests.Context(t), stepOne)
 	require.NoError(t, err)
 
+	zeroStepTimestamps(t, es)
 	gotStep := es.Steps[stepOne.Ref]
 	assert.Equal(t, stepOne, gotStep)
 
@@ -165,6 +184,7 @@ func Test_StoreDB_UpdateStep(t *testing.T) {
 	es, err = store.UpsertStep(tests.Context(t), stepTwo)
 	require.NoError(t, err)
 
+	zeroStepTimestamps(t, es)
 	gotStep = es.Steps[stepTwo.Ref]
 	assert.Equal(t, stepTwo, gotStep)
 }
@@ -211,5 +231,107 @@ func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 	assert.Len(t, states, 1)
 	// Zero out the completedAt timestamp
 	states[0].CreatedAt = nil
+	zeroStepTimestamps(t, states[0])
 	assert.Equal(t, es, states[0])
 }
+
+func Test_StoreDB_List(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	clock := clockwork.NewFakeClock()
+	store := &DBStore{db: db, clock: clock}
+
+	ids := []string{}
+	for i, status := range []string{StatusCompleted, StatusErrored, StatusCompleted} {
+		id := randomID()
+		ids = append(ids, id)
+		es := WorkflowExecution{
+			Steps: map[string]*WorkflowExecutionStep{
+				"step1": {
+					ExecutionID: id,
+					Ref:         "step1",
+					Status:      status,
+				},
+			},
+			ExecutionID: id,
+			Status:      status,
+		}
+		err := store.Add(tests.Context(t), &es)
+		require.NoError(t, err, i)
+		clock.Advance(time.Second)
+	}
+
+	states, count, err := store.List(tests.Context(t), "", "", 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, states, 2)
+	// most recent first
+	assert.Equal(t, ids[2], states[0].ExecutionID)
+	assert.Equal(t, ids[1], states[1].ExecutionID)
+	assert.Equal(t, StatusErrored, states[1].Steps["step1"].Status)
+
+	states, count, err = store.List(tests.Context(t), "", StatusCompleted, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, states, 1)
+	assert.Equal(t, ids[0], states[0].ExecutionID)
+
+	states, count, err = store.List(tests.Context(t), "unknown-workflow", "", 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.Empty(t, states)
+}
+
+func Test_StoreDB_DeleteFinished(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	clock := clockwork.NewFakeClock()
+	store := &DBStore{db: db, clock: clock}
+
+	ids := []string{}
+	for i, status := range []string{StatusCompleted, StatusErrored, StatusCompleted, StatusStarted} {
+		id := randomID()
+		ids = append(ids, id)
+		es := WorkflowExecution{
+			Steps: map[string]*WorkflowExecutionStep{
+				"step1": {
+					ExecutionID: id,
+					Ref:         "step1",
+					Status:      status,
+				},
+			},
+			ExecutionID: id,
+			Status:      StatusStarted,
+		}
+		err := store.Add(tests.Context(t), &es)
+		require.NoError(t, err, i)
+		if status != StatusStarted {
+			err = store.UpdateStatus(tests.Context(t), id, status)
+			require.NoError(t, err, i)
+		}
+		clock.Advance(time.Hour)
+	}
+
+	// only the first execution finished more than 3 hours ago
+	deleted, err := store.DeleteFinishedOlderThan(tests.Context(t), clock.Now().Add(-3*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	_, err = store.Get(tests.Context(t), ids[0])
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// keep the most recent finished execution; unfinished executions are never deleted
+	deleted, err = store.DeleteFinishedAboveLimit(tests.Context(t), 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	states, count, err := store.List(tests.Context(t), "", "", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, states, 2)
+	assert.Equal(t, ids[3], states[0].ExecutionID)
+	assert.Equal(t, ids[2], states[1].ExecutionID)
+
+	var steps int
+	err = db.Get(&steps, `SELECT count(*) FROM workflow_steps WHERE workflow_execution_id = ANY($1)`, pq.Array(ids[:2]))
+	require.NoError(t, err)
+	assert.Equal(t, 0, steps)
+}
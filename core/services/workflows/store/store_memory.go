@@ -3,7 +3,9 @@ package store
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 )
 
 // `InMemoryStore` is a temporary in-memory
@@ -84,3 +86,90 @@ func (s *InMemoryStore) GetUnfinished(ctx context.Context, offset, limit int) ([
 
 	return states, nil
 }
+
+// List returns a page of the executions matching the given filters, most recent first.
+func (s *InMemoryStore) List(ctx context.Context, workflowID string, status string, offset, limit int) ([]WorkflowExecution, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	states := []WorkflowExecution{}
+	for _, s := range s.idToState {
+		if workflowID != "" && s.WorkflowID != workflowID {
+			continue
+		}
+		if status != "" && s.Status != status {
+			continue
+		}
+		states = append(states, *s)
+	}
+
+	sort.Slice(states, func(i, j int) bool {
+		ci, cj := states[i].CreatedAt, states[j].CreatedAt
+		if ci != nil && cj != nil && !ci.Equal(*cj) {
+			return ci.After(*cj)
+		}
+		return states[i].ExecutionID < states[j].ExecutionID
+	})
+
+	count := len(states)
+	if offset >= count {
+		return []WorkflowExecution{}, count, nil
+	}
+	end := count
+	if limit > 0 && offset+limit < count {
+		end = offset + limit
+	}
+	return states[offset:end], count, nil
+}
+
+// DeleteFinishedOlderThan deletes finished executions whose FinishedAt is before the threshold.
+func (s *InMemoryStore) DeleteFinishedOlderThan(ctx context.Context, threshold time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deleted := int64(0)
+	for id, state := range s.idToState {
+		if state.Status == StatusStarted || state.FinishedAt == nil {
+			continue
+		}
+		if state.FinishedAt.Before(threshold) {
+			delete(s.idToState, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// DeleteFinishedAboveLimit deletes the oldest finished executions of each workflow
+// so that at most maxPerWorkflow remain.
+func (s *InMemoryStore) DeleteFinishedAboveLimit(ctx context.Context, maxPerWorkflow int) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	byWorkflow := map[string][]*WorkflowExecution{}
+	for _, state := range s.idToState {
+		if state.Status == StatusStarted {
+			continue
+		}
+		byWorkflow[state.WorkflowID] = append(byWorkflow[state.WorkflowID], state)
+	}
+
+	deleted := int64(0)
+	for _, states := range byWorkflow {
+		if len(states) <= maxPerWorkflow {
+			continue
+		}
+		sort.Slice(states, func(i, j int) bool {
+			ci, cj := states[i].CreatedAt, states[j].CreatedAt
+			if ci != nil && cj != nil && !ci.Equal(*cj) {
+				return ci.After(*cj)
+			}
+			return states[i].ExecutionID < states[j].ExecutionID
+		})
+		for _, state := range states[maxPerWorkflow:] {
+			delete(s.idToState, state.ExecutionID)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,394 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
+)
+
+// schemaCapability is implemented by capabilities which publish JSON schemas
+// for their config, inputs and outputs, e.g. by embedding a capabilities.Validator.
+type schemaCapability interface {
+	ConfigSchema() (string, error)
+	InputsSchema() (string, error)
+	OutputsSchema() (string, error)
+}
+
+type capabilitySchemas struct {
+	config, inputs, outputs string
+}
+
+// ValidateWorkflow checks a workflow spec for mistakes which would otherwise only surface at execution time.
+//
+// In addition to the checks done when parsing the spec, namely that step refs are unique and exist,
+// and that the dependency graph is acyclic, ValidateWorkflow checks that:
+//   - the workflow has at least one trigger
+//   - every interpolated value references the inputs or outputs of a step
+//   - the path of every interpolated value exists in the schema of the referenced capability
+//   - the config and static inputs of every step match the schemas of its capability
+//
+// Schema checks are skipped for capabilities which aren't in the registry, e.g. remote capabilities
+// which haven't been discovered yet, and for capabilities which don't publish schemas.
+func ValidateWorkflow(ctx context.Context, spec string, registry core.CapabilitiesRegistry) error {
+	wf, err := Parse(spec)
+	if err != nil {
+		return err
+	}
+
+	if len(wf.triggers) == 0 {
+		return errors.New("workflow must have at least one trigger")
+	}
+
+	v := &workflowValidator{
+		registry: registry,
+		workflow: wf,
+		schemas:  map[string]*capabilitySchemas{},
+	}
+
+	var errs error
+	for _, t := range wf.triggers {
+		errs = errors.Join(errs, v.validateConfig(ctx, t.stepDefinition))
+	}
+
+	err = wf.walkDo(keywordTrigger, func(s *step) error {
+		if s.Ref == keywordTrigger {
+			return nil
+		}
+
+		errs = errors.Join(errs, v.validateConfig(ctx, s.stepDefinition))
+		errs = errors.Join(errs, v.validateInputs(ctx, s.stepDefinition))
+		errs = errors.Join(errs, v.validateReferences(ctx, s))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return errs
+}
+
+type workflowValidator struct {
+	registry core.CapabilitiesRegistry
+	workflow *workflow
+	// schemas caches the schemas of each capability, nil if the capability doesn't publish any.
+	schemas map[string]*capabilitySchemas
+}
+
+// capabilitySchemas returns the schemas published by the capability, or nil if there are none to check against.
+func (v *workflowValidator) capabilitySchemas(ctx context.Context, id string) (*capabilitySchemas, error) {
+	if s, ok := v.schemas[id]; ok {
+		return s, nil
+	}
+
+	if v.registry == nil {
+		return nil, nil
+	}
+
+	c, err := v.registry.Get(ctx, id)
+	if err != nil {
+		// the capability may not have been discovered yet
+		v.schemas[id] = nil
+		return nil, nil
+	}
+
+	sc, ok := c.(schemaCapability)
+	if !ok {
+		v.schemas[id] = nil
+		return nil, nil
+	}
+
+	s := &capabilitySchemas{}
+	if s.config, err = sc.ConfigSchema(); err != nil {
+		return nil, fmt.Errorf("capability %s: failed to get config schema: %w", id, err)
+	}
+	if s.inputs, err = sc.InputsSchema(); err != nil {
+		return nil, fmt.Errorf("capability %s: failed to get inputs schema: %w", id, err)
+	}
+	if s.outputs, err = sc.OutputsSchema(); err != nil {
+		return nil, fmt.Errorf("capability %s: failed to get outputs schema: %w", id, err)
+	}
+
+	v.schemas[id] = s
+	return s, nil
+}
+
+func (v *workflowValidator) validateConfig(ctx context.Context, s stepDefinition) error {
+	schemas, err := v.capabilitySchemas(ctx, s.ID)
+	if err != nil || schemas == nil {
+		return err
+	}
+
+	config := s.Config
+	if config == nil {
+		config = map[string]any{}
+	}
+
+	if err := validateAgainstSchema(schemas.config, config, nil); err != nil {
+		return fmt.Errorf("step %s: invalid config for capability %s: %w", stepName(s), s.ID, err)
+	}
+	return nil
+}
+
+// validateInputs validates the step's inputs, ignoring interpolated values since they're only known at execution time.
+func (v *workflowValidator) validateInputs(ctx context.Context, s stepDefinition) error {
+	schemas, err := v.capabilitySchemas(ctx, s.ID)
+	if err != nil || schemas == nil {
+		return err
+	}
+
+	inputs := s.Inputs
+	if inputs == nil {
+		inputs = map[string]any{}
+	}
+
+	ignore := func(instanceLocation string) bool {
+		return isInterpolated(inputs, instanceLocation)
+	}
+	if err := validateAgainstSchema(schemas.inputs, inputs, ignore); err != nil {
+		return fmt.Errorf("step %s: invalid inputs for capability %s: %w", stepName(s), s.ID, err)
+	}
+	return nil
+}
+
+// validateReferences checks that every value interpolated into the step's inputs or `forEach`
+// refers to the inputs or outputs of a step, and that the referenced path is published by the step's capability.
+func (v *workflowValidator) validateReferences(ctx context.Context, s *step) error {
+	keys := []string{}
+	collect := func(el string) (any, error) {
+		if matches := interpolationTokenRe.FindStringSubmatch(el); len(matches) == 2 {
+			keys = append(keys, matches[1])
+		}
+		return el, nil
+	}
+	if _, err := deepMap(s.Inputs, collect); err != nil {
+		return err
+	}
+	if _, err := deepMap(s.ForEach, collect); err != nil {
+		return err
+	}
+
+	var errs error
+	for _, key := range keys {
+		parts := strings.Split(key, ".")
+		if parts[0] == keywordForEach {
+			if s.ForEach == "" {
+				errs = errors.Join(errs, fmt.Errorf("step %s: `$(%s)` can only be used in steps with a forEach", s.Ref, key))
+			}
+			continue
+		}
+
+		if len(parts) < 2 || (parts[1] != "inputs" && parts[1] != "outputs") {
+			errs = errors.Join(errs, fmt.Errorf("step %s: invalid reference `$(%s)`: must reference the inputs or outputs of a step", s.Ref, key))
+			continue
+		}
+
+		for _, id := range v.capabilityIDs(parts[0]) {
+			schemas, err := v.capabilitySchemas(ctx, id)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			if schemas == nil {
+				continue
+			}
+
+			schema := schemas.outputs
+			if parts[1] == "inputs" {
+				schema = schemas.inputs
+			}
+
+			ok, err := schemaHasPath(schema, parts[2:])
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("capability %s: %w", id, err))
+			} else if !ok {
+				errs = errors.Join(errs, fmt.Errorf("step %s: `$(%s)` is not part of the %s of capability %s", s.Ref, key, parts[1], id))
+			}
+		}
+	}
+
+	return errs
+}
+
+// capabilityIDs returns the IDs of the capabilities which may have executed the referenced step.
+// Any of the workflow's triggers may have started an execution.
+func (v *workflowValidator) capabilityIDs(ref string) []string {
+	if ref == keywordTrigger {
+		ids := []string{}
+		for _, t := range v.workflow.triggers {
+			ids = append(ids, t.ID)
+		}
+		return ids
+	}
+
+	s, err := v.workflow.Vertex(ref)
+	if err != nil {
+		return nil
+	}
+	return []string{s.ID}
+}
+
+func stepName(s stepDefinition) string {
+	if s.Ref != "" {
+		return s.Ref
+	}
+	return s.ID
+}
+
+// validateAgainstSchema validates the value against the JSON schema, skipping any violations
+// for which `ignore` returns true.
+func validateAgainstSchema(schema string, value map[string]any, ignore func(instanceLocation string) bool) error {
+	compiled, err := jsonschema.CompileString("schema.json", schema)
+	if err != nil {
+		return fmt.Errorf("invalid schema: %w", err)
+	}
+
+	err = compiled.Validate(toJSONValue(value))
+	var ve *jsonschema.ValidationError
+	if !errors.As(err, &ve) {
+		return err
+	}
+
+	var errs error
+	for _, leaf := range leafErrors(ve) {
+		if ignore != nil && ignore(leaf.InstanceLocation) {
+			continue
+		}
+
+		location := leaf.InstanceLocation
+		if location == "" {
+			location = "/"
+		}
+		errs = errors.Join(errs, fmt.Errorf("%s: %s", location, leaf.Message))
+	}
+	return errs
+}
+
+func leafErrors(ve *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(ve.Causes) == 0 {
+		return []*jsonschema.ValidationError{ve}
+	}
+
+	leaves := []*jsonschema.ValidationError{}
+	for _, c := range ve.Causes {
+		leaves = append(leaves, leafErrors(c)...)
+	}
+	return leaves
+}
+
+// isInterpolated returns true if the value at the JSON pointer `instanceLocation`,
+// or any value containing it, is interpolated at execution time.
+func isInterpolated(value any, instanceLocation string) bool {
+	segments := strings.Split(instanceLocation, "/")
+	for i, segment := range segments {
+		if el, ok := value.(string); ok {
+			return interpolationTokenRe.MatchString(el)
+		}
+
+		// the first segment is the root of the JSON pointer
+		if i == 0 {
+			continue
+		}
+
+		segment = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+		switch tv := value.(type) {
+		case map[string]any:
+			value = tv[segment]
+		case mapping:
+			value = tv[segment]
+		case []any:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(tv) {
+				return false
+			}
+			value = tv[idx]
+		default:
+			return false
+		}
+	}
+
+	el, ok := value.(string)
+	return ok && interpolationTokenRe.MatchString(el)
+}
+
+// toJSONValue converts a value parsed from a workflow spec into the types expected by the JSON schema validator.
+func toJSONValue(value any) any {
+	switch tv := value.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(tv))
+		for k, v := range tv {
+			m[k] = toJSONValue(v)
+		}
+		return m
+	case mapping:
+		return toJSONValue(map[string]any(tv))
+	case []any:
+		l := make([]any, len(tv))
+		for i, v := range tv {
+			l[i] = toJSONValue(v)
+		}
+		return l
+	case decimal.Decimal:
+		return json.Number(tv.String())
+	case int64:
+		return json.Number(strconv.FormatInt(tv, 10))
+	default:
+		return value
+	}
+}
+
+// schemaHasPath returns false if the JSON schema rules out a value existing at `path`.
+// Parts of the schema which don't describe the structure of their values accept any path.
+func schemaHasPath(schema string, path []string) (bool, error) {
+	var s any
+	if err := json.Unmarshal([]byte(schema), &s); err != nil {
+		return false, fmt.Errorf("invalid schema: %w", err)
+	}
+
+	for _, p := range path {
+		obj, ok := s.(map[string]any)
+		if !ok {
+			// boolean schemas, e.g. `true`, describe any value
+			return true, nil
+		}
+
+		if props, ok := obj["properties"].(map[string]any); ok {
+			if sub, ok := props[p]; ok {
+				s = sub
+				continue
+			}
+		}
+
+		if items, ok := obj["items"]; ok {
+			if _, err := strconv.Atoi(p); err != nil {
+				return false, nil
+			}
+			s = items
+			continue
+		}
+
+		switch ap := obj["additionalProperties"].(type) {
+		case bool:
+			if !ap {
+				return false, nil
+			}
+			return true, nil
+		case map[string]any:
+			s = ap
+			continue
+		}
+
+		// additional properties are allowed unless stated otherwise,
+		// and the schema may not describe the value's structure at all
+		return true, nil
+	}
+
+	return true, nil
+}
@@ -0,0 +1,286 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type testConsensusConfig struct {
+	AggregationMethod string `json:"aggregation_method" jsonschema:"enum=data_feeds_2_0"`
+	Encoder           string `json:"encoder,omitempty"`
+}
+
+type testConsensusInputs struct {
+	Observations []any `json:"observations"`
+}
+
+type testConsensusOutputs struct {
+	Report      string `json:"report"`
+	Signatures  []string
+	Aggregation struct {
+		Median string `json:"median"`
+	} `json:"aggregation"`
+}
+
+type schemaConsensus struct {
+	capabilities.CapabilityInfo
+	*mockCapability
+	capabilities.Validator[testConsensusConfig, testConsensusInputs, testConsensusOutputs]
+}
+
+func newSchemaConsensus() *schemaConsensus {
+	info := capabilities.MustNewCapabilityInfo(
+		"offchain_reporting",
+		capabilities.CapabilityTypeConsensus,
+		"an ocr3 consensus capability",
+		"v3.0.0",
+		nil,
+	)
+	return &schemaConsensus{
+		CapabilityInfo: info,
+		mockCapability: newMockCapability(info, nil),
+		Validator:      capabilities.NewValidator[testConsensusConfig, testConsensusInputs, testConsensusOutputs](capabilities.ValidatorArgs{Info: info}),
+	}
+}
+
+const validatedWorkflow = `
+triggers:
+  - id: "mercury-trigger"
+    config:
+      feedIds:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000"
+
+consensus:
+  - id: "offchain_reporting"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+
+targets:
+  - id: "write_ethereum-testnet-sepolia"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+      median: "$(evm_median.outputs.aggregation.median)"
+      signature: "$(evm_median.outputs.Signatures.0)"
+      observations: "$(evm_median.inputs.observations)"
+    config:
+      address: "0x54e220867af6683aE6DcBF535B4f952cB5116510"
+`
+
+func TestValidateWorkflow(t *testing.T) {
+	testCases := []struct {
+		name   string
+		yaml   string
+		errMsg []string
+	}{
+		{
+			name: "valid",
+			yaml: validatedWorkflow,
+		},
+		{
+			name: "no triggers",
+			yaml: `
+consensus:
+  - id: "offchain_reporting"
+    inputs:
+      observations: []
+    config: {}
+`,
+			errMsg: []string{"all non-trigger steps must have a dependent ref"},
+		},
+		{
+			name: "unknown ref",
+			yaml: `
+triggers:
+  - id: "mercury-trigger"
+    config: {}
+targets:
+  - id: "write_ethereum-testnet-sepolia"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config: {}
+`,
+			errMsg: []string{"step write_ethereum-testnet-sepolia: invalid dependency on step evm_median", "vertex not found"},
+		},
+		{
+			name: "duplicate ref",
+			yaml: `
+triggers:
+  - id: "mercury-trigger"
+    config: {}
+actions:
+  - id: "read_chain_action"
+    ref: "read"
+    inputs:
+      trigger: "$(trigger.outputs)"
+    config: {}
+  - id: "read_chain_action"
+    ref: "read"
+    inputs:
+      trigger: "$(trigger.outputs)"
+    config: {}
+`,
+			errMsg: []string{"duplicate step ref read"},
+		},
+		{
+			name: "reserved ref",
+			yaml: `
+triggers:
+  - id: "mercury-trigger"
+    config: {}
+actions:
+  - id: "read_chain_action"
+    ref: "trigger"
+    inputs:
+      trigger: "$(trigger.outputs)"
+    config: {}
+`,
+			errMsg: []string{"ref `trigger` is reserved for triggers"},
+		},
+		{
+			name: "invalid reference",
+			yaml: `
+triggers:
+  - id: "mercury-trigger"
+    config: {}
+targets:
+  - id: "write_ethereum-testnet-sepolia"
+    inputs:
+      report: "$(trigger.report)"
+      element: "$(forEach.item)"
+    config: {}
+`,
+			errMsg: []string{
+				"invalid reference `$(trigger.report)`: must reference the inputs or outputs of a step",
+				"`$(forEach.item)` can only be used in steps with a forEach",
+			},
+		},
+		{
+			name: "invalid config",
+			yaml: `
+triggers:
+  - id: "mercury-trigger"
+    config: {}
+consensus:
+  - id: "offchain_reporting"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "median"
+      encoder: 1
+`,
+			errMsg: []string{
+				"step evm_median: invalid config for capability offchain_reporting",
+				"/aggregation_method: value must be",
+				"/encoder: expected string, but got number",
+			},
+		},
+		{
+			name: "invalid inputs",
+			yaml: `
+triggers:
+  - id: "mercury-trigger"
+    config: {}
+consensus:
+  - id: "offchain_reporting"
+    ref: "evm_median"
+    inputs:
+      observations: "not a list"
+      extra: "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+`,
+			errMsg: []string{
+				"step evm_median: invalid inputs for capability offchain_reporting",
+				"/observations: expected array, but got string",
+				"additionalProperties 'extra' not allowed",
+			},
+		},
+		{
+			name: "missing inputs",
+			yaml: `
+triggers:
+  - id: "mercury-trigger"
+    config: {}
+consensus:
+  - id: "offchain_reporting"
+    ref: "evm_median"
+    inputs:
+      extra: "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+`,
+			errMsg: []string{"missing properties: 'observations'"},
+		},
+		{
+			name: "unknown output",
+			yaml: `
+triggers:
+  - id: "mercury-trigger"
+    config: {}
+consensus:
+  - id: "offchain_reporting"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+targets:
+  - id: "write_ethereum-testnet-sepolia"
+    inputs:
+      report: "$(evm_median.outputs.reports)"
+      median: "$(evm_median.outputs.aggregation.mean)"
+      signature: "$(evm_median.outputs.Signatures.first)"
+    config: {}
+`,
+			errMsg: []string{
+				"step write_ethereum-testnet-sepolia: `$(evm_median.outputs.reports)` is not part of the outputs of capability offchain_reporting",
+				"`$(evm_median.outputs.aggregation.mean)` is not part of the outputs",
+				"`$(evm_median.outputs.Signatures.first)` is not part of the outputs",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testutils.Context(t)
+			reg := coreCap.NewRegistry(logger.TestLogger(t))
+			require.NoError(t, reg.Add(ctx, newSchemaConsensus()))
+			// capabilities without schemas are only checked structurally
+			trigger, _ := mockTrigger(t)
+			require.NoError(t, reg.Add(ctx, trigger))
+
+			err := ValidateWorkflow(ctx, tc.yaml, reg)
+			if len(tc.errMsg) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, msg := range tc.errMsg {
+				assert.ErrorContains(t, err, msg)
+			}
+		})
+	}
+}
+
+func TestValidateWorkflow_WithoutRegistry(t *testing.T) {
+	err := ValidateWorkflow(testutils.Context(t), validatedWorkflow, nil)
+	require.NoError(t, err)
+
+	err = ValidateWorkflow(testutils.Context(t), "", nil)
+	assert.ErrorContains(t, err, "workflow must have at least one trigger")
+}
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE workflow_status
+RENAME TO workflow_status_old;
+
+CREATE TYPE workflow_status AS ENUM (
+	'started',
+	'errored',
+	'timeout',
+	'completed',
+	'skipped'
+);
+
+ALTER TABLE workflow_executions
+ALTER COLUMN status TYPE workflow_status USING status::TEXT::workflow_status;
+
+ALTER TABLE workflow_steps
+ALTER COLUMN status TYPE workflow_status USING status::TEXT::workflow_status;
+
+DROP TYPE workflow_status_old;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TYPE workflow_status
+RENAME TO workflow_status_old;
+
+CREATE TYPE workflow_status AS ENUM (
+	'started',
+	'errored',
+	'timeout',
+	'completed'
+);
+
+-- This will fail if any records are using the 'skipped' enum.
+-- Manually update these as we cannot decide what you want to do with them.
+ALTER TABLE workflow_executions
+ALTER COLUMN status TYPE workflow_status USING status::TEXT::workflow_status;
+
+ALTER TABLE workflow_steps
+ALTER COLUMN status TYPE workflow_status USING status::TEXT::workflow_status;
+
+DROP TYPE workflow_status_old;
+-- +goose StatementEnd
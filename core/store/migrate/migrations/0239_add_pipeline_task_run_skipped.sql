@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pipeline_task_runs
+	ADD COLUMN skipped boolean NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pipeline_task_runs
+	DROP COLUMN skipped;
+-- +goose StatementEnd
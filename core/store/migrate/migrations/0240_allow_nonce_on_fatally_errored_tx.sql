@@ -0,0 +1,34 @@
+-- +goose Up
+-- Purged transactions are marked fatally errored after their nonce was consumed by the purge transaction, so
+-- they keep their nonce.
+ALTER TABLE evm.txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE evm.txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+    state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL AND initial_broadcast_at IS NULL
+    OR
+    state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL AND initial_broadcast_at IS NULL
+    OR
+    state = 'fatal_error'::eth_txes_state AND error IS NOT NULL
+    OR
+    state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+) NOT VALID; -- NOT VALID gives large speedup and this is a relaxing of the constraint so its safe
+
+-- +goose Down
+UPDATE evm.txes SET nonce=NULL WHERE state='fatal_error';
+ALTER TABLE evm.txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE evm.txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+    state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL AND initial_broadcast_at IS NULL
+    OR
+    state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL AND initial_broadcast_at IS NULL
+    OR
+    state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL
+    OR
+    state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+) NOT VALID; -- NOT VALID gives large speedup and we know data is valid because of update above
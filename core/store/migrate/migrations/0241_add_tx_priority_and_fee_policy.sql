@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE evm.txes
+	ADD COLUMN priority integer NOT NULL DEFAULT 0,
+	ADD COLUMN fee_policy jsonb;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE evm.txes
+	DROP COLUMN priority,
+	DROP COLUMN fee_policy;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE evm.txes
+	ADD COLUMN key_pool bytea[];
+CREATE INDEX idx_eth_txes_unstarted_key_pool ON evm.txes USING GIN (key_pool) WHERE state = 'unstarted' AND key_pool IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS evm.idx_eth_txes_unstarted_key_pool;
+ALTER TABLE evm.txes
+	DROP COLUMN key_pool;
+-- +goose StatementEnd
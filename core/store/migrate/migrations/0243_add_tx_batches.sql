@@ -0,0 +1,47 @@
+-- +goose Up
+ALTER TABLE evm.txes
+	ADD COLUMN batch_policy jsonb,
+	ADD COLUMN batch_tx_id bigint REFERENCES evm.txes (id) ON DELETE CASCADE;
+CREATE INDEX idx_eth_txes_batch_tx_id ON evm.txes (batch_tx_id) WHERE batch_tx_id IS NOT NULL;
+CREATE INDEX idx_eth_txes_unstarted_batch_policy ON evm.txes (evm_chain_id, from_address, created_at) WHERE state = 'unstarted' AND batch_policy IS NOT NULL AND batch_tx_id IS NULL;
+
+-- Batched txs are confirmed along with their batch tx, which has the nonce.
+ALTER TABLE evm.txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE evm.txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+    state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL AND initial_broadcast_at IS NULL
+    OR
+    state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL AND initial_broadcast_at IS NULL
+    OR
+    state = 'fatal_error'::eth_txes_state AND error IS NOT NULL
+    OR
+    state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND batch_tx_id IS NOT NULL AND nonce IS NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+) NOT VALID; -- NOT VALID gives large speedup and this is a relaxing of the constraint so its safe
+
+-- +goose Down
+-- The calls of batched txs are made by their batch txs, so they are dropped along with the batches.
+DELETE FROM evm.txes WHERE batch_tx_id IS NOT NULL;
+ALTER TABLE evm.txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE evm.txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+    state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL AND initial_broadcast_at IS NULL
+    OR
+    state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL AND initial_broadcast_at IS NULL
+    OR
+    state = 'fatal_error'::eth_txes_state AND error IS NOT NULL
+    OR
+    state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+) NOT VALID; -- NOT VALID gives large speedup and we know data is valid because of delete above
+DROP INDEX IF EXISTS evm.idx_eth_txes_unstarted_batch_policy;
+DROP INDEX IF EXISTS evm.idx_eth_txes_batch_tx_id;
+ALTER TABLE evm.txes
+	DROP COLUMN batch_tx_id,
+	DROP COLUMN batch_policy;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Txs stuck at the max gas price are purged at that price, so the purge attempt shares the gas price of the
+-- broadcast attempt it replaces, which is kept in case it is mined instead.
+DROP INDEX IF EXISTS evm.idx_eth_tx_attempts_unique_gas_prices;
+
+-- +goose Down
+DELETE FROM evm.tx_attempts a USING evm.tx_attempts b WHERE a.eth_tx_id = b.eth_tx_id AND a.gas_price = b.gas_price AND a.id < b.id;
+CREATE UNIQUE INDEX idx_eth_tx_attempts_unique_gas_prices ON evm.tx_attempts USING btree (eth_tx_id, gas_price);
@@ -3,7 +3,10 @@ package web
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
 
+	commontxmgr "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
@@ -47,3 +50,60 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Cancel cancels an unstarted or unconfirmed Ethereum Transaction, by deleting it or replacing it with an
+// empty transaction respectively.
+// Example:
+//
+//	"<application>/transactions/evm/:ID/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	tx, err := tc.App.TxmStorageService().FindTxWithAttempts(c, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chain, err := tc.App.GetRelayers().LegacyEVMChains().Get(tx.ChainID.String())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	etx, err := chain.TxManager().Cancel(c, id)
+	if errors.Is(err, commontxmgr.ErrTxNotFound) {
+		// deleted since it was looked up, e.g. by the broadcaster or reaper
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if errors.Is(err, commontxmgr.ErrTxNotCancellable) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionCancelled, map[string]interface{}{
+		"ethTX": etx,
+	})
+
+	if len(etx.TxAttempts) > 0 {
+		etx.TxAttempts[0].Tx = etx
+		jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(etx.TxAttempts[0]), "transaction")
+		return
+	}
+	resource := presenters.NewEthTxResource(etx)
+	resource.JAID = presenters.NewJAIDInt64(etx.ID)
+	jsonAPIResponse(c, resource, "transaction")
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
@@ -129,3 +130,44 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	t.Run("replaces unconfirmed tx", func(t *testing.T) {
+		tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, from)
+
+		resp, cleanup := client.Post(fmt.Sprintf("/v2/transactions/evm/%d/cancel", tx.ID), nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		ptx := presenters.EthTxResource{}
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+		assert.Equal(t, string(txmgrcommon.TxUnconfirmed), ptx.State)
+		assert.Equal(t, from, *ptx.To)
+		assert.Equal(t, "1", ptx.Nonce)
+		assert.NotEqual(t, tx.TxAttempts[0].Hash, ptx.Hash)
+	})
+
+	t.Run("rejects confirmed tx", func(t *testing.T) {
+		tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, from)
+
+		resp, cleanup := client.Post(fmt.Sprintf("/v2/transactions/evm/%d/cancel", tx.ID), nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/transactions/evm/999999/cancel", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
@@ -90,6 +91,55 @@ func (jc *JobsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
 }
 
+// Graph renders the pipeline of a job, found by ID or external job ID, as SVG by default or as DOT with ?format=dot.
+// Example:
+// "GET <application>/jobs/:ID/graph"
+func (jc *JobsController) Graph(c *gin.Context) {
+	ctx := c.Request.Context()
+	var err error
+	jobSpec := job.Job{}
+	if externalJobID, pErr := uuid.Parse(c.Param("ID")); pErr == nil {
+		jobSpec, err = jc.App.JobORM().FindJobByExternalJobID(ctx, externalJobID)
+	} else if pErr = jobSpec.SetID(c.Param("ID")); pErr == nil {
+		jobSpec, err = jc.App.JobORM().FindJob(ctx, jobSpec.ID)
+	} else {
+		jsonAPIError(c, http.StatusUnprocessableEntity, pErr)
+		return
+	}
+	if err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	renderPipelineGraph(c, jobSpec.PipelineSpec.DotDagSource, nil)
+}
+
+// renderPipelineGraph responds with the pipeline parsed from source, annotated with the run if it's non-nil,
+// in the format requested by the format query parameter.
+func renderPipelineGraph(c *gin.Context, source string, run *pipeline.Run) {
+	format := c.DefaultQuery("format", "svg")
+	if format != "svg" && format != "dot" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unsupported format %q, expected svg or dot", format))
+		return
+	}
+
+	p, err := pipeline.Parse(source)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse pipeline"))
+		return
+	}
+
+	if format == "dot" {
+		c.Data(http.StatusOK, "text/vnd.graphviz; charset=utf-8", []byte(pipeline.RenderDOT(p, run)))
+	} else {
+		c.Data(http.StatusOK, "image/svg+xml; charset=utf-8", []byte(pipeline.RenderSVG(p, run)))
+	}
+}
+
 // CreateJobRequest represents a request to create and start a job (V2).
 type CreateJobRequest struct {
 	TOML string `json:"toml"`
@@ -254,7 +304,7 @@ func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string
 	case job.Stream:
 		jb, err = streams.ValidatedStreamSpec(tomlString)
 	case job.Workflow:
-		jb, err = workflows.ValidatedWorkflowSpec(tomlString)
+		jb, err = workflows.ValidatedWorkflowSpec(ctx, tomlString, jc.App.GetCapabilitiesRegistry())
 	default:
 		return jb, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType)
 	}
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -85,6 +86,31 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, res, "pipelineRun")
 }
 
+// Graph renders the pipeline of a run, with each task annotated with the status, duration and error of its
+// task run. It's rendered as SVG by default or as DOT with ?format=dot.
+// Example:
+// "GET <application>/jobs/:ID/runs/:runID/graph"
+func (prc *PipelineRunsController) Graph(c *gin.Context) {
+	ctx := c.Request.Context()
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	pipelineRun, err = prc.App.PipelineORM().FindRun(ctx, pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	renderPipelineGraph(c, pipelineRun.PipelineSpec.DotDagSource, &pipelineRun)
+}
+
 // Create triggers a pipeline run for a job.
 // Example:
 // "POST <application>/jobs/:ID/runs"
@@ -155,6 +181,38 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
 }
 
+// Replay re-executes a finished pipeline run with its original inputs, using the results recorded in
+// the original run for tasks which call out to the network, and compares the results with the original run.
+// Example:
+// "POST <application>/pipeline/runs/:runID/replay"
+func (prc *PipelineRunsController) Replay(c *gin.Context) {
+	ctx := c.Request.Context()
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	pipelineRun, err = prc.App.PipelineORM().FindRun(ctx, pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	replay, err := prc.App.ReplayJobRunV2(ctx, pipelineRun)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	res := presenters.NewPipelineRunReplayResource(pipelineRun, *replay, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRunReplay")
+}
+
 // Resume finishes a task and resumes the pipeline run.
 // Example:
 // "PATCH <application>/jobs/:ID/runs/:runID"
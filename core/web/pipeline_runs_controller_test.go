@@ -239,6 +239,51 @@ func TestPipelineRunsController_Show_HappyPath(t *testing.T) {
 	require.Len(t, parsedResponse.TaskRuns, 8)
 }
 
+func TestPipelineRunsController_Graph(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+
+	t.Run("run as DOT", func(t *testing.T) {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%v/runs/%v/graph?format=dot", jobID, runIDs[0]))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		assert.Equal(t, "text/vnd.graphviz; charset=utf-8", response.Header.Get("Content-Type"))
+
+		body := string(cltest.ParseResponseBody(t, response))
+		assert.Contains(t, body, `"ds1" -> "ds1_parse";`)
+		assert.Contains(t, body, `"ds3" [label="ds3\nfail\nerrored`)
+		assert.Contains(t, body, `uh oh`)
+	})
+
+	t.Run("run as SVG", func(t *testing.T) {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%v/runs/%v/graph", jobID, runIDs[0]))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		assert.Equal(t, "image/svg+xml; charset=utf-8", response.Header.Get("Content-Type"))
+		assert.Contains(t, string(cltest.ParseResponseBody(t, response)), `<g id="task-answer">`)
+	})
+
+	t.Run("job", func(t *testing.T) {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%v/graph?format=dot", jobID))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		body := string(cltest.ParseResponseBody(t, response))
+		assert.Contains(t, body, `"ds3" [label="ds3\nfail" fillcolor="#ffffff"];`)
+		assert.NotContains(t, body, "errored")
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%v/graph?format=png", jobID))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("missing run", func(t *testing.T) {
+		response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%v/runs/999999/graph", jobID))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
+
 func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	t.Parallel()
 	app := cltest.NewApplicationEVMDisabled(t)
@@ -67,6 +67,7 @@ type PipelineTaskRunResource struct {
 	Output     *string           `json:"output"`
 	Error      *string           `json:"error"`
 	DotID      string            `json:"dotId"`
+	Skipped    bool              `json:"skipped"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -75,16 +76,7 @@ func (r PipelineTaskRunResource) GetName() string {
 }
 
 func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
-	var output *string
-	if tr.Output.Valid {
-		outputBytes, _ := tr.Output.MarshalJSON()
-		outputStr := string(outputBytes)
-		output = &outputStr
-	}
-	var errString *string
-	if tr.Error.Valid {
-		errString = &tr.Error.String
-	}
+	output, errString := taskRunOutputAndError(tr)
 	return PipelineTaskRunResource{
 		Type:       tr.Type,
 		CreatedAt:  tr.CreatedAt,
@@ -92,9 +84,23 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 		Output:     output,
 		Error:      errString,
 		DotID:      tr.GetDotID(),
+		Skipped:    tr.Skipped,
 	}
 }
 
+// taskRunOutputAndError returns the output of the task run as JSON, and its error.
+func taskRunOutputAndError(tr pipeline.TaskRun) (output *string, errString *string) {
+	if tr.Output.Valid {
+		outputBytes, _ := tr.Output.MarshalJSON()
+		outputStr := string(outputBytes)
+		output = &outputStr
+	}
+	if tr.Error.Valid {
+		errString = &tr.Error.String
+	}
+	return output, errString
+}
+
 func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineRunResource {
 	var out []PipelineRunResource
 
@@ -104,3 +110,97 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineRunReplayResource compares a replayed pipeline run with the original run.
+type PipelineRunReplayResource struct {
+	JAID
+	Outputs             []*string                       `json:"outputs"`
+	FatalErrors         []*string                       `json:"fatalErrors"`
+	OriginalOutputs     []*string                       `json:"originalOutputs"`
+	OriginalFatalErrors []*string                       `json:"originalFatalErrors"`
+	TaskRuns            []PipelineTaskRunReplayResource `json:"taskRuns"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineRunReplayResource) GetName() string {
+	return "pipelineRunReplay"
+}
+
+// PipelineTaskRunReplayResource compares the result of a task in a replayed run with its result in the original run.
+type PipelineTaskRunReplayResource struct {
+	DotID string            `json:"dotId"`
+	Type  pipeline.TaskType `json:"type"`
+	// Recorded is true if the task wasn't executed, and returned its result in the original run instead
+	Recorded        bool    `json:"recorded"`
+	Output          *string `json:"output"`
+	Error           *string `json:"error"`
+	Skipped         bool    `json:"skipped"`
+	OriginalOutput  *string `json:"originalOutput"`
+	OriginalError   *string `json:"originalError"`
+	OriginalSkipped bool    `json:"originalSkipped"`
+	Changed         bool    `json:"changed"`
+}
+
+// NewPipelineRunReplayResource compares the replay of a run with the original run. The ID is the ID of the original run.
+func NewPipelineRunReplayResource(original pipeline.Run, replay pipeline.Run, lggr logger.Logger) PipelineRunReplayResource {
+	lggr = lggr.Named("PipelineRunReplayResource")
+
+	outputs, err := replay.StringOutputs()
+	if err != nil {
+		lggr.Errorw(err.Error(), "out", replay.Outputs)
+	}
+	originalOutputs, err := original.StringOutputs()
+	if err != nil {
+		lggr.Errorw(err.Error(), "out", original.Outputs)
+	}
+
+	var trs []PipelineTaskRunReplayResource
+	replayed := map[string]bool{}
+	for _, tr := range replay.PipelineTaskRuns {
+		replayed[tr.DotID] = true
+		res := PipelineTaskRunReplayResource{
+			DotID:    tr.DotID,
+			Type:     tr.Type,
+			Recorded: pipeline.IsReplayedTaskType(tr.Type),
+			Skipped:  tr.Skipped,
+		}
+		res.Output, res.Error = taskRunOutputAndError(tr)
+		if otr := original.ByDotID(tr.DotID); otr != nil {
+			res.OriginalOutput, res.OriginalError = taskRunOutputAndError(*otr)
+			res.OriginalSkipped = otr.Skipped
+		}
+		res.Changed = !equalStringPtrs(res.Output, res.OriginalOutput) || !equalStringPtrs(res.Error, res.OriginalError) || res.Skipped != res.OriginalSkipped
+		trs = append(trs, res)
+	}
+	// tasks which ran originally, but not in the replay
+	for _, otr := range original.PipelineTaskRuns {
+		if replayed[otr.DotID] {
+			continue
+		}
+		res := PipelineTaskRunReplayResource{
+			DotID:           otr.DotID,
+			Type:            otr.Type,
+			Recorded:        pipeline.IsReplayedTaskType(otr.Type),
+			OriginalSkipped: otr.Skipped,
+			Changed:         true,
+		}
+		res.OriginalOutput, res.OriginalError = taskRunOutputAndError(otr)
+		trs = append(trs, res)
+	}
+
+	return PipelineRunReplayResource{
+		JAID:                NewJAIDInt64(original.ID),
+		Outputs:             outputs,
+		FatalErrors:         replay.StringFatalErrors(),
+		OriginalOutputs:     originalOutputs,
+		OriginalFatalErrors: original.StringFatalErrors(),
+		TaskRuns:            trs,
+	}
+}
+
+func equalStringPtrs(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
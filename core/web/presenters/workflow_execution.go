@@ -0,0 +1,138 @@
+package presenters
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResource represents a workflow execution JSONAPI resource.
+type WorkflowExecutionResource struct {
+	JAID
+	WorkflowID string                          `json:"workflowID"`
+	Status     string                          `json:"status"`
+	Steps      []WorkflowExecutionStepResource `json:"steps"`
+	CreatedAt  *time.Time                      `json:"createdAt"`
+	UpdatedAt  *time.Time                      `json:"updatedAt"`
+	FinishedAt *time.Time                      `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowExecutionResource) GetName() string {
+	return "workflowExecutions"
+}
+
+// NewWorkflowExecutionResource constructs a new WorkflowExecutionResource.
+// Steps are ordered by the time they were last updated.
+func NewWorkflowExecutionResource(we store.WorkflowExecution, lggr logger.Logger) WorkflowExecutionResource {
+	lggr = lggr.Named("WorkflowExecutionResource")
+	steps := []WorkflowExecutionStepResource{}
+	for _, s := range we.Steps {
+		steps = append(steps, NewWorkflowExecutionStepResource(*s, lggr))
+	}
+	sort.Slice(steps, func(i, j int) bool {
+		ui, uj := steps[i].UpdatedAt, steps[j].UpdatedAt
+		if ui != nil && uj != nil && !ui.Equal(*uj) {
+			return ui.Before(*uj)
+		}
+		return steps[i].Ref < steps[j].Ref
+	})
+
+	return WorkflowExecutionResource{
+		JAID:       NewJAID(we.ExecutionID),
+		WorkflowID: we.WorkflowID,
+		Status:     we.Status,
+		Steps:      steps,
+		CreatedAt:  we.CreatedAt,
+		UpdatedAt:  we.UpdatedAt,
+		FinishedAt: we.FinishedAt,
+	}
+}
+
+// NewWorkflowExecutionResources constructs a slice of WorkflowExecutionResources.
+func NewWorkflowExecutionResources(wes []store.WorkflowExecution, lggr logger.Logger) []WorkflowExecutionResource {
+	rs := []WorkflowExecutionResource{}
+	for _, we := range wes {
+		rs = append(rs, NewWorkflowExecutionResource(we, lggr))
+	}
+
+	return rs
+}
+
+// WorkflowExecutionStepResource represents a step of a workflow execution.
+// Inputs and outputs are JSON encoded.
+type WorkflowExecutionStepResource struct {
+	Ref       string              `json:"ref"`
+	Status    string              `json:"status"`
+	Inputs    *string             `json:"inputs"`
+	Outputs   *string             `json:"outputs"`
+	Error     *string             `json:"error"`
+	Attempts  []store.StepAttempt `json:"attempts"`
+	UpdatedAt *time.Time          `json:"updatedAt"`
+}
+
+// NewWorkflowExecutionStepResource constructs a new WorkflowExecutionStepResource.
+func NewWorkflowExecutionStepResource(s store.WorkflowExecutionStep, lggr logger.Logger) WorkflowExecutionStepResource {
+	r := WorkflowExecutionStepResource{
+		Ref:       s.Ref,
+		Status:    s.Status,
+		Attempts:  s.Attempts,
+		UpdatedAt: s.UpdatedAt,
+	}
+
+	if s.Inputs != nil {
+		r.Inputs = marshalWorkflowValue(s.Inputs, lggr)
+	}
+
+	if s.Outputs != nil {
+		if s.Outputs.Value != nil {
+			r.Outputs = marshalWorkflowValue(s.Outputs.Value, lggr)
+		}
+		if s.Outputs.Err != nil {
+			errString := s.Outputs.Err.Error()
+			r.Error = &errString
+		}
+	}
+
+	return r
+}
+
+func marshalWorkflowValue(v values.Value, lggr logger.Logger) *string {
+	unwrapped, err := values.Unwrap(v)
+	if err != nil {
+		lggr.Errorw("failed to unwrap workflow value", "err", err)
+		return nil
+	}
+
+	b, err := json.Marshal(unwrapped)
+	if err != nil {
+		lggr.Errorw("failed to marshal workflow value", "err", err)
+		return nil
+	}
+
+	str := string(b)
+	return &str
+}
+
+// WorkflowTriggerEventResource represents an event sent to a workflow through the HTTP trigger.
+type WorkflowTriggerEventResource struct {
+	JAID
+	WorkflowID string `json:"workflowID"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowTriggerEventResource) GetName() string {
+	return "workflowTriggerEvents"
+}
+
+// NewWorkflowTriggerEventResource constructs a new WorkflowTriggerEventResource.
+func NewWorkflowTriggerEventResource(eventID string, workflowID string) WorkflowTriggerEventResource {
+	return WorkflowTriggerEventResource{
+		JAID:       NewJAID(eventID),
+		WorkflowID: workflowID,
+	}
+}
@@ -1049,7 +1049,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	case job.Gateway:
 		jb, err = gateway.ValidatedGatewaySpec(args.Input.TOML)
 	case job.Workflow:
-		jb, err = workflows.ValidatedWorkflowSpec(args.Input.TOML)
+		jb, err = workflows.ValidatedWorkflowSpec(ctx, args.Input.TOML, r.App.GetCapabilitiesRegistry())
 	default:
 		return NewCreateJobPayload(r.App, nil, map[string]string{
 			"Job Type": fmt.Sprintf("unknown job type: %s", jbt),
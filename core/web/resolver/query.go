@@ -575,3 +575,53 @@ func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadRe
 
 	return NewOCR2KeyBundlesPayload(ekbs), nil
 }
+
+// WorkflowExecution retrieves a workflow execution by its execution ID.
+func (r *Resolver) WorkflowExecution(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*WorkflowExecutionPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	we, err := r.App.WorkflowORM().Get(ctx, string(args.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewWorkflowExecutionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewWorkflowExecutionPayload(&we, nil), nil
+}
+
+// WorkflowExecutions retrieves a paginated list of workflow executions, most recent first.
+func (r *Resolver) WorkflowExecutions(ctx context.Context, args struct {
+	WorkflowID *string
+	Status     *WorkflowStatus
+	Offset     *int32
+	Limit      *int32
+}) (*WorkflowExecutionsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var workflowID, status string
+	if args.WorkflowID != nil {
+		workflowID = *args.WorkflowID
+	}
+	if args.Status != nil {
+		status = FromWorkflowStatus(*args.Status)
+	}
+
+	limit := pageLimit(args.Limit)
+	offset := pageOffset(args.Offset)
+
+	wes, count, err := r.App.WorkflowORM().List(ctx, workflowID, status, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowExecutionsPayload(wes, int32(count)), nil
+}
@@ -59,3 +59,7 @@ func (r *TaskRunResolver) FinishedAt() *graphql.Time {
 func (r *TaskRunResolver) DotID() string {
 	return r.tr.GetDotID()
 }
+
+func (r *TaskRunResolver) Skipped() bool {
+	return r.tr.Skipped
+}
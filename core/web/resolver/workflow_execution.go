@@ -0,0 +1,215 @@
+package resolver
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowStatus maps to the enum type WorkflowStatus in workflow_execution.graphql
+type WorkflowStatus string
+
+const (
+	WorkflowStatusUnknown   WorkflowStatus = "UNKNOWN"
+	WorkflowStatusStarted   WorkflowStatus = "STARTED"
+	WorkflowStatusErrored   WorkflowStatus = "ERRORED"
+	WorkflowStatusTimeout   WorkflowStatus = "TIMEOUT"
+	WorkflowStatusCompleted WorkflowStatus = "COMPLETED"
+	WorkflowStatusSkipped   WorkflowStatus = "SKIPPED"
+)
+
+// ToWorkflowStatus converts a workflow store status into a WorkflowStatus
+func ToWorkflowStatus(status string) WorkflowStatus {
+	switch status {
+	case store.StatusStarted, store.StatusErrored, store.StatusTimeout, store.StatusCompleted, store.StatusSkipped:
+		return WorkflowStatus(strings.ToUpper(status))
+	default:
+		return WorkflowStatusUnknown
+	}
+}
+
+// FromWorkflowStatus converts a WorkflowStatus into a workflow store status
+func FromWorkflowStatus(status WorkflowStatus) string {
+	return strings.ToLower(string(status))
+}
+
+type WorkflowExecutionResolver struct {
+	we store.WorkflowExecution
+}
+
+func NewWorkflowExecution(we store.WorkflowExecution) *WorkflowExecutionResolver {
+	return &WorkflowExecutionResolver{we: we}
+}
+
+func NewWorkflowExecutions(wes []store.WorkflowExecution) []*WorkflowExecutionResolver {
+	var resolvers []*WorkflowExecutionResolver
+
+	for _, we := range wes {
+		resolvers = append(resolvers, NewWorkflowExecution(we))
+	}
+
+	return resolvers
+}
+
+func (r *WorkflowExecutionResolver) ID() graphql.ID {
+	return graphql.ID(r.we.ExecutionID)
+}
+
+func (r *WorkflowExecutionResolver) WorkflowID() string {
+	return r.we.WorkflowID
+}
+
+func (r *WorkflowExecutionResolver) Status() WorkflowStatus {
+	return ToWorkflowStatus(r.we.Status)
+}
+
+// Steps resolves the execution's steps, ordered by the time they were last updated.
+func (r *WorkflowExecutionResolver) Steps() []*WorkflowExecutionStepResolver {
+	steps := []*WorkflowExecutionStepResolver{}
+	for _, s := range r.we.Steps {
+		steps = append(steps, &WorkflowExecutionStepResolver{step: *s})
+	}
+
+	sort.Slice(steps, func(i, j int) bool {
+		ui, uj := steps[i].step.UpdatedAt, steps[j].step.UpdatedAt
+		if ui != nil && uj != nil && !ui.Equal(*uj) {
+			return ui.Before(*uj)
+		}
+		return steps[i].step.Ref < steps[j].step.Ref
+	})
+
+	return steps
+}
+
+func (r *WorkflowExecutionResolver) CreatedAt() *graphql.Time {
+	if r.we.CreatedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.we.CreatedAt}
+}
+
+func (r *WorkflowExecutionResolver) UpdatedAt() *graphql.Time {
+	if r.we.UpdatedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.we.UpdatedAt}
+}
+
+func (r *WorkflowExecutionResolver) FinishedAt() *graphql.Time {
+	if r.we.FinishedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.we.FinishedAt}
+}
+
+type WorkflowExecutionStepResolver struct {
+	step store.WorkflowExecutionStep
+}
+
+func (r *WorkflowExecutionStepResolver) Ref() string {
+	return r.step.Ref
+}
+
+func (r *WorkflowExecutionStepResolver) Status() WorkflowStatus {
+	return ToWorkflowStatus(r.step.Status)
+}
+
+// Inputs resolves the JSON encoded inputs of the step
+func (r *WorkflowExecutionStepResolver) Inputs() *string {
+	if r.step.Inputs == nil {
+		return nil
+	}
+
+	return workflowValueToJSON(r.step.Inputs)
+}
+
+// Outputs resolves the JSON encoded outputs of the step
+func (r *WorkflowExecutionStepResolver) Outputs() *string {
+	if r.step.Outputs == nil || r.step.Outputs.Value == nil {
+		return nil
+	}
+
+	return workflowValueToJSON(r.step.Outputs.Value)
+}
+
+func (r *WorkflowExecutionStepResolver) Error() *string {
+	if r.step.Outputs == nil || r.step.Outputs.Err == nil {
+		return nil
+	}
+
+	errString := r.step.Outputs.Err.Error()
+	return &errString
+}
+
+func (r *WorkflowExecutionStepResolver) UpdatedAt() *graphql.Time {
+	if r.step.UpdatedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.step.UpdatedAt}
+}
+
+func workflowValueToJSON(v values.Value) *string {
+	unwrapped, err := values.Unwrap(v)
+	if err != nil {
+		errMsg := "error: unable to unwrap value: " + err.Error()
+		return &errMsg
+	}
+
+	b, err := json.Marshal(unwrapped)
+	if err != nil {
+		errMsg := "error: unable to marshal value: " + err.Error()
+		return &errMsg
+	}
+
+	str := string(b)
+	return &str
+}
+
+// -- WorkflowExecution Query --
+
+type WorkflowExecutionPayloadResolver struct {
+	we *store.WorkflowExecution
+	NotFoundErrorUnionType
+}
+
+func NewWorkflowExecutionPayload(we *store.WorkflowExecution, err error) *WorkflowExecutionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "workflow execution not found"}
+
+	return &WorkflowExecutionPayloadResolver{we: we, NotFoundErrorUnionType: e}
+}
+
+// ToWorkflowExecution implements the WorkflowExecutionPayload union type of the payload
+func (r *WorkflowExecutionPayloadResolver) ToWorkflowExecution() (*WorkflowExecutionResolver, bool) {
+	if r.we != nil {
+		return NewWorkflowExecution(*r.we), true
+	}
+
+	return nil, false
+}
+
+// -- WorkflowExecutions Query --
+
+// WorkflowExecutionsPayloadResolver resolves a page of workflow executions
+type WorkflowExecutionsPayloadResolver struct {
+	wes   []store.WorkflowExecution
+	total int32
+}
+
+func NewWorkflowExecutionsPayload(wes []store.WorkflowExecution, total int32) *WorkflowExecutionsPayloadResolver {
+	return &WorkflowExecutionsPayloadResolver{wes: wes, total: total}
+}
+
+// Results returns the workflow executions.
+func (r *WorkflowExecutionsPayloadResolver) Results() []*WorkflowExecutionResolver {
+	return NewWorkflowExecutions(r.wes)
+}
+
+// Metadata returns the pagination metadata.
+func (r *WorkflowExecutionsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
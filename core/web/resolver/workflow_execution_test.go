@@ -0,0 +1,173 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func newWorkflowExecutionStore(t *testing.T) *store.InMemoryStore {
+	inputs, err := values.NewMap(map[string]any{"foo": "bar"})
+	require.NoError(t, err)
+
+	s := store.NewInMemoryStore()
+	require.NoError(t, s.Add(context.Background(), &store.WorkflowExecution{
+		ExecutionID: "execution-1",
+		WorkflowID:  "workflow-1",
+		Status:      store.StatusErrored,
+		Steps: map[string]*store.WorkflowExecutionStep{
+			"trigger": {
+				ExecutionID: "execution-1",
+				Ref:         "trigger",
+				Status:      store.StatusCompleted,
+				Outputs:     &store.StepOutput{Value: values.NewString("output")},
+			},
+			"evm_median": {
+				ExecutionID: "execution-1",
+				Ref:         "evm_median",
+				Status:      store.StatusErrored,
+				Inputs:      inputs,
+				Outputs:     &store.StepOutput{Err: errors.New("fatal consensus error")},
+			},
+		},
+	}))
+	require.NoError(t, s.Add(context.Background(), &store.WorkflowExecution{
+		ExecutionID: "execution-2",
+		WorkflowID:  "workflow-2",
+		Status:      store.StatusCompleted,
+		Steps:       map[string]*store.WorkflowExecutionStep{},
+	}))
+	return s
+}
+
+func TestQuery_PaginatedWorkflowExecutions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecutions($workflowID: String, $status: WorkflowStatus) {
+			workflowExecutions(workflowID: $workflowID, status: $status) {
+				results {
+					id
+					workflowID
+					status
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "workflowExecutions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("WorkflowORM").Return(newWorkflowExecutionStore(t))
+			},
+			query: query,
+			result: `
+				{
+					"workflowExecutions": {
+						"results": [{
+							"id": "execution-1",
+							"workflowID": "workflow-1",
+							"status": "ERRORED"
+						}, {
+							"id": "execution-2",
+							"workflowID": "workflow-2",
+							"status": "COMPLETED"
+						}],
+						"metadata": {
+							"total": 2
+						}
+					}
+				}`,
+		},
+		{
+			name:          "filtered by status",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("WorkflowORM").Return(newWorkflowExecutionStore(t))
+			},
+			query:     query,
+			variables: map[string]interface{}{"status": "COMPLETED"},
+			result: `
+				{
+					"workflowExecutions": {
+						"results": [{
+							"id": "execution-2",
+							"workflowID": "workflow-2",
+							"status": "COMPLETED"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_WorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecution($id: ID!) {
+			workflowExecution(id: $id) {
+				... on WorkflowExecution {
+					id
+					status
+					steps {
+						ref
+						status
+						inputs
+						outputs
+						error
+					}
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: map[string]interface{}{"id": "execution-1"}}, "workflowExecution"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("WorkflowORM").Return(newWorkflowExecutionStore(t))
+			},
+			query:     query,
+			variables: map[string]interface{}{"id": "execution-1"},
+			result: `
+				{
+					"workflowExecution": {
+						"id": "execution-1",
+						"status": "ERRORED",
+						"steps": [{
+							"ref": "evm_median",
+							"status": "ERRORED",
+							"inputs": "{\"foo\":\"bar\"}",
+							"outputs": null,
+							"error": "fatal consensus error"
+						}, {
+							"ref": "trigger",
+							"status": "COMPLETED",
+							"inputs": null,
+							"outputs": "\"output\"",
+							"error": null
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
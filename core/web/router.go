@@ -286,6 +286,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
+		authv2.POST("/transactions/evm/:ID/cancel", auth.RequiresAdminRole(txs.Cancel))
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
@@ -370,14 +371,26 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
+		authv2.GET("/jobs/:ID/graph", jc.Graph)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
+		authv2.POST("/pipeline/runs/:runID/replay", auth.RequiresRunRole(prc.Replay))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.GET("/jobs/:ID/runs/:runID/graph", prc.Graph)
+
+		// WorkflowExecutionsController
+		wec := WorkflowExecutionsController{app}
+		authv2.GET("/workflows/executions", paginatedRequest(wec.Index))
+		authv2.GET("/workflows/executions/:ID", wec.Show)
+
+		// WorkflowTriggersController
+		wtc := WorkflowTriggersController{app}
+		authv2.POST("/workflows/:ID/trigger", auth.RequiresRunRole(wtc.Create))
 
 		// FeaturesController
 		fc := FeaturesController{app}
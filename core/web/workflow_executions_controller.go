@@ -0,0 +1,60 @@
+package web
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowExecutionsController manages workflow execution history requests.
+type WorkflowExecutionsController struct {
+	App chainlink.Application
+}
+
+// Index returns a page of workflow executions, most recent first.
+// Executions can be filtered by workflow ID and status.
+// Example:
+// "GET <application>/workflows/executions?workflowID=<id>&status=errored"
+func (wec *WorkflowExecutionsController) Index(c *gin.Context, size, page, offset int) {
+	status := c.Query("status")
+	switch status {
+	case "", store.StatusStarted, store.StatusCompleted, store.StatusErrored, store.StatusTimeout, store.StatusSkipped:
+	default:
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid status %q", status))
+		return
+	}
+
+	executions, count, err := wec.App.WorkflowORM().List(c.Request.Context(), c.Query("workflowID"), status, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewWorkflowExecutionResources(executions, wec.App.GetLogger())
+	paginatedResponse(c, "workflowExecutions", size, page, res, count, err)
+}
+
+// Show returns a workflow execution, including its steps.
+// Example:
+// "GET <application>/workflows/executions/:ID"
+func (wec *WorkflowExecutionsController) Show(c *gin.Context) {
+	execution, err := wec.App.WorkflowORM().Get(c.Request.Context(), c.Param("ID"))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow execution not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewWorkflowExecutionResource(execution, wec.App.GetLogger())
+	jsonAPIResponse(c, res, "workflowExecution")
+}
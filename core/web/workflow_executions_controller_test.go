@@ -0,0 +1,99 @@
+package web_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowExecutionsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	setupWorkflowExecutions(t, app.WorkflowORM())
+
+	resp, cleanup := client.Get("/v2/workflows/executions?status=unknown")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Get("/v2/workflows/executions?status=errored")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	resources := []presenters.WorkflowExecutionResource{}
+	err := web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &resources, &links)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "execution-errored", resources[0].ID)
+	require.Len(t, resources[0].Steps, 1)
+	assert.Equal(t, "fatal consensus error", *resources[0].Steps[0].Error)
+}
+
+func TestWorkflowExecutionsController_Show(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	setupWorkflowExecutions(t, app.WorkflowORM())
+
+	resp, cleanup := client.Get("/v2/workflows/executions/execution-completed")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var resource presenters.WorkflowExecutionResource
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resource)
+	require.NoError(t, err)
+	assert.Equal(t, "execution-completed", resource.ID)
+	assert.Equal(t, store.StatusCompleted, resource.Status)
+	require.Len(t, resource.Steps, 1)
+	assert.Equal(t, `"output"`, *resource.Steps[0].Outputs)
+
+	resp, cleanup = client.Get("/v2/workflows/executions/missing")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func setupWorkflowExecutions(t *testing.T, s store.Store) {
+	ctx := testutils.Context(t)
+	require.NoError(t, s.Add(ctx, &store.WorkflowExecution{
+		ExecutionID: "execution-completed",
+		Status:      store.StatusCompleted,
+		Steps: map[string]*store.WorkflowExecutionStep{
+			"trigger": {
+				ExecutionID: "execution-completed",
+				Ref:         "trigger",
+				Status:      store.StatusCompleted,
+				Outputs:     &store.StepOutput{Value: values.NewString("output")},
+			},
+		},
+	}))
+	require.NoError(t, s.Add(ctx, &store.WorkflowExecution{
+		ExecutionID: "execution-errored",
+		Status:      store.StatusErrored,
+		Steps: map[string]*store.WorkflowExecutionStep{
+			"evm_median": {
+				ExecutionID: "execution-errored",
+				Ref:         "evm_median",
+				Status:      store.StatusErrored,
+				Outputs:     &store.StepOutput{Err: errors.New("fatal consensus error")},
+			},
+		},
+	}))
+}
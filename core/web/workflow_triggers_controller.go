@@ -0,0 +1,47 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowTriggersController sends authenticated payloads to workflows using the HTTP trigger.
+type WorkflowTriggersController struct {
+	App chainlink.Application
+}
+
+// Create sends the JSON object in the request body to the workflow's HTTP trigger.
+// Example:
+// "POST <application>/workflows/:ID/trigger"
+func (wtc *WorkflowTriggersController) Create(c *gin.Context) {
+	workflowID := c.Param("ID")
+
+	payload := map[string]any{}
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("payload must be a JSON object: %w", err))
+		return
+	}
+
+	eventID, err := wtc.App.HTTPTrigger().SendEvent(c.Request.Context(), workflowID, payload)
+	switch {
+	case errors.Is(err, triggers.ErrWorkflowNotRegistered):
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	case errors.Is(err, triggers.ErrSubscriberFull):
+		jsonAPIError(c, http.StatusServiceUnavailable, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	res := presenters.NewWorkflowTriggerEventResource(eventID, workflowID)
+	jsonAPIResponseWithStatus(c, res, "workflowTriggerEvent", http.StatusAccepted)
+}
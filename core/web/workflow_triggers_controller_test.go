@@ -0,0 +1,55 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowTriggersController_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Post("/v2/workflows/workflow-1/trigger", bytes.NewBufferString(`{"price": 100}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	inputs, err := values.NewMap(map[string]any{"triggerId": "trigger-1"})
+	require.NoError(t, err)
+	ch, err := app.HTTPTrigger().RegisterTrigger(ctx, capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{WorkflowID: "workflow-1"},
+		Inputs:   inputs,
+		Config:   values.EmptyMap(),
+	})
+	require.NoError(t, err)
+
+	resp, cleanup = client.Post("/v2/workflows/workflow-1/trigger", bytes.NewBufferString(`[1, 2]`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/workflows/workflow-1/trigger", bytes.NewBufferString(`{"price": 100}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusAccepted)
+
+	var resource presenters.WorkflowTriggerEventResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resource))
+	assert.Equal(t, "workflow-1", resource.WorkflowID)
+
+	event := <-ch
+	te := capabilities.TriggerEvent{}
+	require.NoError(t, event.Value.UnwrapTo(&te))
+	assert.Equal(t, resource.ID, te.ID)
+}